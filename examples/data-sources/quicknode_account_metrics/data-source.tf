@@ -0,0 +1,16 @@
+data "quicknode_account_metrics" "latency" {
+  period     = "day"
+  metric     = "method_response_time_max"
+  percentile = "p95"
+}
+
+check "p95_latency" {
+  assert {
+    condition     = alltrue([for s in data.quicknode_account_metrics.latency.series : s.last == null || s.last < 500])
+    error_message = "The p95 response time of at least one method is above 500ms."
+  }
+}
+
+output "p95_latency_by_method" {
+  value = { for s in data.quicknode_account_metrics.latency.series : s.tag => s.avg }
+}
@@ -4,3 +4,14 @@ data "quicknode_chains" "all" {}
 output "chains" {
   value = data.quicknode_chains.all
 }
+
+# Look up the QuickNode chain and network slugs of an EVM chain ID.
+data "quicknode_chains" "base" {
+  chain_id       = 8453
+  single_network = true
+}
+
+resource "quicknode_endpoint" "base" {
+  chain   = data.quicknode_chains.base.match.chain
+  network = data.quicknode_chains.base.match.network
+}
@@ -0,0 +1,31 @@
+# Requests received by the endpoint in the last 15 minutes.
+data "quicknode_endpoint_logs" "recent" {
+  endpoint_id = "your-endpoint-id"
+  start_time  = "15m"
+  max_records = 500
+}
+
+# Post-deploy smoke check: the endpoint receives traffic and most of it succeeds.
+check "endpoint_traffic" {
+  assert {
+    condition     = data.quicknode_endpoint_logs.recent.total_count > 0
+    error_message = "The endpoint received no requests in the last 15 minutes."
+  }
+
+  assert {
+    condition     = data.quicknode_endpoint_logs.recent.error_count <= data.quicknode_endpoint_logs.recent.total_count / 20
+    error_message = "More than 5% of the recent requests to the endpoint failed."
+  }
+}
+
+# The last 10 failed requests, with their request and response bodies.
+data "quicknode_endpoint_logs" "detailed" {
+  endpoint_id     = "your-endpoint-id"
+  start_time      = "24h"
+  max_records     = 10
+  include_details = true
+}
+
+output "failed_requests" {
+  value = [for log in data.quicknode_endpoint_logs.detailed.logs : log if log.error_code != null]
+}
@@ -0,0 +1,22 @@
+data "quicknode_endpoint_metrics" "example" {
+  endpoint_id = "111111"
+  period      = "hour"
+  metric      = "response_status_over_time"
+}
+
+locals {
+  # Total number of responses per status code over the last hour.
+  responses_by_status = {
+    for s in data.quicknode_endpoint_metrics.example.series :
+    s.tag => sum(concat([0], [for p in s.points : p.value]))
+  }
+  total_responses = sum(concat([0], values(local.responses_by_status)))
+  error_responses = sum(concat([0], [for status, count in local.responses_by_status : count if startswith(status, "5")]))
+}
+
+check "error_ratio" {
+  assert {
+    condition     = local.total_responses == 0 || local.error_responses / local.total_responses < 0.01
+    error_message = "More than 1% of the requests in the last hour returned a 5xx status."
+  }
+}
@@ -6,3 +6,17 @@ data "quicknode_endpoints" "example" {
 output "endpoints" {
   value = data.quicknode_endpoints.example
 }
+
+# Every active production endpoint of the account, across all pages.
+data "quicknode_endpoints" "prod" {
+  all        = true
+  tag_labels = ["env:prod"]
+  status     = "active"
+}
+
+resource "quicknode_endpoint_rate_limits" "prod" {
+  for_each = { for endpoint in data.quicknode_endpoints.prod.endpoints : endpoint.id => endpoint }
+
+  endpoint_id = each.key
+  rps         = 100
+}
@@ -0,0 +1,19 @@
+# All invoices of the account.
+data "quicknode_invoices" "all" {}
+
+# Open invoices created in the last 30 days.
+data "quicknode_invoices" "open" {
+  start_time = "30d"
+  status     = "open"
+}
+
+check "outstanding_balance" {
+  assert {
+    condition     = data.quicknode_invoices.open.total_amount_due - data.quicknode_invoices.open.total_amount_paid < 50000
+    error_message = "More than $500 is outstanding on open invoices."
+  }
+}
+
+output "invoice_ids" {
+  value = data.quicknode_invoices.all.invoices[*].id
+}
@@ -0,0 +1,22 @@
+# Payments made in 2024.
+data "quicknode_payments" "year" {
+  start_time = "2024-01-01T00:00:00Z"
+  end_time   = "2025-01-01T00:00:00Z"
+}
+
+# Failed payments of the last 30 days.
+data "quicknode_payments" "failed" {
+  start_time = "30d"
+  status     = "failed"
+}
+
+check "no_failed_payments" {
+  assert {
+    condition     = length(data.quicknode_payments.failed.payments) == 0
+    error_message = "A payment failed in the last 30 days."
+  }
+}
+
+output "payments" {
+  value = data.quicknode_payments.year.payments
+}
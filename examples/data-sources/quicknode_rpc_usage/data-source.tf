@@ -0,0 +1,19 @@
+# Usage of the current billing period.
+data "quicknode_rpc_usage" "billing_period" {}
+
+# Usage of the last 24 hours, broken down by endpoint.
+data "quicknode_rpc_usage" "last_day" {
+  start_time = "24h"
+  group_by   = "endpoint"
+}
+
+check "credit_budget" {
+  assert {
+    condition     = data.quicknode_rpc_usage.billing_period.credits_remaining > 1000000
+    error_message = "Less than 1M credits remain in the current billing period."
+  }
+}
+
+output "usage_by_endpoint" {
+  value = data.quicknode_rpc_usage.last_day.endpoint_usage
+}
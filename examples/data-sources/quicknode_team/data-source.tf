@@ -0,0 +1,7 @@
+data "quicknode_team" "example" {
+  id = "1234"
+}
+
+output "team" {
+  value = data.quicknode_team.example
+}
@@ -0,0 +1,5 @@
+data "quicknode_teams" "example" {}
+
+output "teams" {
+  value = data.quicknode_teams.example
+}
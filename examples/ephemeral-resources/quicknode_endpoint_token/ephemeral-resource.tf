@@ -0,0 +1,32 @@
+resource "quicknode_endpoint" "example" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+
+  security_options = {
+    tokens = true # Must be set to true to use endpoint tokens
+  }
+}
+
+# Create a token for this run only. It is deleted once the run is over.
+ephemeral "quicknode_endpoint_token" "run" {
+  endpoint_id = quicknode_endpoint.example.id
+}
+
+# Fetch a token managed elsewhere without copying it into this state.
+ephemeral "quicknode_endpoint_token" "existing" {
+  endpoint_id = quicknode_endpoint.example.id
+  token_id    = "5f9c4d2e-1234-4abc-9def-0123456789ab"
+}
+
+# Hand the token to a write-only attribute of another provider, such as a
+# Vault secret, so it never lands in the state.
+resource "vault_kv_secret_v2" "quicknode" {
+  mount = "secret"
+  name  = "quicknode/example"
+
+  data_json_wo = jsonencode({
+    token    = ephemeral.quicknode_endpoint_token.existing.token
+    http_url = ephemeral.quicknode_endpoint_token.existing.http_url
+  })
+  data_json_wo_version = 1
+}
@@ -15,3 +15,15 @@ provider "quicknode" {
   # Set via QUICKNODE_API_KEY environment variable, or override here:
   # api_key = "QN_xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
 }
+
+provider "quicknode" {
+  alias = "patient"
+
+  # Retry failed requests up to 5 times, waiting at most a minute between attempts.
+  max_retries    = 5
+  retry_max_wait = "1m"
+
+  # Stay below the account rate limit when running with high parallelism.
+  requests_per_second     = 5
+  max_concurrent_requests = 4
+}
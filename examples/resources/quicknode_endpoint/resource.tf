@@ -2,6 +2,10 @@ resource "quicknode_endpoint" "example" {
   chain   = "optimism"
   network = "optimism-sepolia"
   label   = "test-chain"
+  status  = "active" # Set to "paused" to pause the endpoint
+
+  # Read the client IP from this header when the endpoint sits behind a proxy.
+  ip_custom_header = "X-Forwarded-For"
 
   security_options = {
     tokens          = true
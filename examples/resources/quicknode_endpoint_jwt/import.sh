@@ -0,0 +1 @@
+terraform import quicknode_endpoint_jwt.example <endpoint_id>/<jwt_id>
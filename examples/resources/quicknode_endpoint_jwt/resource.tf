@@ -0,0 +1,31 @@
+resource "quicknode_endpoint" "example" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "test-chain"
+
+  security_options = {
+    tokens       = false
+    referrers    = false
+    jwts         = true # Must be set to true to use the endpoint_jwt resource
+    ips          = false
+    domain_masks = false
+    hsts         = false
+    cors         = true
+  }
+}
+
+resource "quicknode_endpoint_jwt" "example" {
+  name        = "backend-signer"
+  kid         = "backend-signer-2026"
+  public_key  = file("${path.module}/jwt_public_key.pem")
+  endpoint_id = quicknode_endpoint.example.id
+}
+
+# Keep the public key out of the state with a write-only argument. Bump the
+# version to replace the JWT with the current key.
+resource "quicknode_endpoint_jwt" "write_only" {
+  name                  = "backend-signer-wo"
+  public_key_wo         = file("${path.module}/jwt_public_key.pem")
+  public_key_wo_version = 1
+  endpoint_id           = quicknode_endpoint.example.id
+}
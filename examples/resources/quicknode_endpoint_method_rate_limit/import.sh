@@ -0,0 +1 @@
+terraform import quicknode_endpoint_method_rate_limit.example <endpoint_id>/<method_rate_limit_id>
@@ -0,0 +1,13 @@
+resource "quicknode_endpoint" "example" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "test-chain"
+}
+
+# Cap expensive calls so they cannot eat up the credits of the endpoint.
+resource "quicknode_endpoint_method_rate_limit" "example" {
+  endpoint_id = quicknode_endpoint.example.id
+  interval    = "second"
+  methods     = ["eth_getLogs", "debug_traceTransaction"]
+  rate        = 5
+}
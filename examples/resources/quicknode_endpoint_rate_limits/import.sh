@@ -0,0 +1 @@
+terraform import quicknode_endpoint_rate_limits.example <endpoint_id>
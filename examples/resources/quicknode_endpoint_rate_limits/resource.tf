@@ -0,0 +1,12 @@
+resource "quicknode_endpoint" "example" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "test-chain"
+}
+
+resource "quicknode_endpoint_rate_limits" "example" {
+  endpoint_id = quicknode_endpoint.example.id
+  rps         = 25
+  rpm         = 1000
+  rpd         = 500000
+}
@@ -0,0 +1 @@
+terraform import quicknode_endpoint_referrer.example <endpoint_id>/<referrer_id>
@@ -0,0 +1,28 @@
+locals {
+  allowed_referrers = [
+    "https://app.example.com",
+    "https://staging.example.com",
+  ]
+}
+
+resource "quicknode_endpoint" "example" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "test-chain"
+
+  security_options = {
+    tokens       = true
+    referrers    = true # Must be set to true to use the endpoint_referrer resource
+    jwts         = false
+    ips          = false
+    domain_masks = false
+    hsts         = false
+    cors         = true
+  }
+}
+
+resource "quicknode_endpoint_referrer" "example" {
+  for_each    = toset(local.allowed_referrers)
+  referrer    = each.value
+  endpoint_id = quicknode_endpoint.example.id
+}
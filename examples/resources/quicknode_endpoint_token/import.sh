@@ -0,0 +1 @@
+terraform import quicknode_endpoint_token.example <endpoint_id>/<token_id>
@@ -0,0 +1,29 @@
+resource "quicknode_endpoint" "example" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "test-chain"
+
+  security_options = {
+    tokens       = true # Must be set to true to use the endpoint_token resource
+    referrers    = false
+    jwts         = false
+    ips          = false
+    domain_masks = false
+    hsts         = false
+    cors         = true
+  }
+}
+
+# Bump the rotation value to replace the token on the next apply.
+resource "quicknode_endpoint_token" "example" {
+  endpoint_id = quicknode_endpoint.example.id
+
+  keepers = {
+    rotation = "2026-10"
+  }
+}
+
+output "endpoint_token" {
+  value     = quicknode_endpoint_token.example.token
+  sensitive = true
+}
@@ -0,0 +1 @@
+terraform import quicknode_team.example <team_id>
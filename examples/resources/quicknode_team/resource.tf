@@ -0,0 +1,7 @@
+resource "quicknode_team" "example" {
+  name = "platform"
+}
+
+output "team" {
+  value = quicknode_team.example
+}
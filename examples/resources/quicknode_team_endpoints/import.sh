@@ -0,0 +1 @@
+terraform import quicknode_team_endpoints.example <team_id>/<endpoint_id>,<endpoint_id>
@@ -0,0 +1,20 @@
+resource "quicknode_team" "example" {
+  name = "payments"
+}
+
+resource "quicknode_endpoint" "example" {
+  for_each = toset(["optimism-sepolia", "optimism"])
+
+  chain   = "optimism"
+  network = each.key
+  label   = "payments-${each.key}"
+}
+
+resource "quicknode_team_endpoints" "example" {
+  team_id      = quicknode_team.example.id
+  endpoint_ids = [for endpoint in quicknode_endpoint.example : endpoint.id]
+}
+
+output "team_endpoints" {
+  value = quicknode_team_endpoints.example
+}
@@ -0,0 +1 @@
+terraform import quicknode_team_member.example <team_id>/<user_id>
@@ -0,0 +1,20 @@
+resource "quicknode_team" "example" {
+  name = "platform"
+}
+
+resource "quicknode_team_member" "example" {
+  team_id   = quicknode_team.example.id
+  email     = "jane.doe@example.com"
+  full_name = "Jane Doe"
+  role      = "viewer"
+
+  # Change this value to resend the invitation while it is still pending.
+  resend_trigger = "1"
+
+  # Delete the user from the account when removing it from the team.
+  destroy_user = false
+}
+
+output "team_member" {
+  value = quicknode_team_member.example
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+// Chains returns the catalog of supported chains and networks. The catalog is
+// fetched once per client, so once per configured provider, and failed
+// fetches are retried on the next call.
+func (c *Client) Chains(ctx context.Context) ([]api.Chain, error) {
+	c.chainsMu.Lock()
+	defer c.chainsMu.Unlock()
+
+	if c.chains != nil {
+		return c.chains, nil
+	}
+
+	chainsResp, err := c.API.ChainsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching chains: %w", err)
+	}
+	if chainsResp.StatusCode() != http.StatusOK || chainsResp.JSON200 == nil {
+		return nil, fmt.Errorf("fetching chains: %w", NewAPIError(chainsResp.HTTPResponse, chainsResp.Body))
+	}
+
+	c.chains = chainsResp.JSON200.Data
+	if c.chains == nil {
+		c.chains = []api.Chain{}
+	}
+	return c.chains, nil
+}
@@ -6,6 +6,7 @@ package client
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
@@ -17,10 +18,53 @@ const HostURL string = "https://api.quicknode.com"
 // Client wraps the generated QuickNode API client.
 type Client struct {
 	API *api.ClientWithResponses
+
+	// chains caches the chains catalog for the lifetime of the client.
+	chainsMu sync.Mutex
+	chains   []api.Chain
+}
+
+// Option configures the client created by NewClient.
+type Option func(*options)
+
+// options holds the settings applied by the options of NewClient.
+type options struct {
+	maxRetries            int
+	retryMaxWait          time.Duration
+	requestsPerSecond     float64
+	maxConcurrentRequests int
+}
+
+// WithMaxRetries sets how many times a failed request is retried. Zero disables retries.
+func WithMaxRetries(maxRetries int) Option {
+	return func(o *options) {
+		o.maxRetries = maxRetries
+	}
+}
+
+// WithRetryMaxWait sets the longest wait between two attempts of a request.
+func WithRetryMaxWait(maxWait time.Duration) Option {
+	return func(o *options) {
+		o.retryMaxWait = maxWait
+	}
+}
+
+// WithRequestsPerSecond limits the rate of requests. Zero removes the limit.
+func WithRequestsPerSecond(requestsPerSecond float64) Option {
+	return func(o *options) {
+		o.requestsPerSecond = requestsPerSecond
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests in flight at once. Zero removes the limit.
+func WithMaxConcurrentRequests(maxConcurrent int) Option {
+	return func(o *options) {
+		o.maxConcurrentRequests = maxConcurrent
+	}
 }
 
 // NewClient creates a new QuickNode API client.
-func NewClient(endpoint, apiKey *string) (*Client, error) {
+func NewClient(endpoint, apiKey *string, opts ...Option) (*Client, error) {
 	host := HostURL
 	if endpoint != nil {
 		host = *endpoint
@@ -31,8 +75,26 @@ func NewClient(endpoint, apiKey *string) (*Client, error) {
 		key = *apiKey
 	}
 
+	o := options{
+		maxRetries:            DefaultMaxRetries,
+		retryMaxWait:          DefaultRetryMaxWait,
+		maxConcurrentRequests: DefaultMaxConcurrentRequests,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// Every attempt of a request, retries included, goes through the
+	// throttle, and the timeout of an attempt only starts once the throttle
+	// lets it through: retry -> throttle -> attempt timeout -> HTTP.
+	transport := newTimeoutTransport(http.DefaultTransport, attemptTimeout)
+	throttle := newThrottleTransport(transport, o.requestsPerSecond, o.maxConcurrentRequests)
+	httpClient := &http.Client{
+		Transport: newRetryTransport(throttle, o.maxRetries, o.retryMaxWait),
+	}
+
 	c, err := api.NewClientWithResponses(host,
-		api.WithHTTPClient(&http.Client{Timeout: 10 * time.Second}),
+		api.WithHTTPClient(httpClient),
 		api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
 			req.Header.Set("User-Agent", "terraform-provider-quicknode")
 			req.Header.Set("Accept", "application/json")
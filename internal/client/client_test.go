@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestNewClient_Defaults(t *testing.T) {
@@ -88,3 +89,66 @@ func TestNewClient_NoAPIKey_OmitsHeader(t *testing.T) {
 		t.Errorf("expected no x-api-key header, got %q", got)
 	}
 }
+
+func TestClient_ChainsIsCached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"slug":"eth","networks":[{"slug":"mainnet","chain_id":1}]}],"error":null}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(&server.URL, nil, WithMaxRetries(0))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := c.Chains(context.Background()); err == nil {
+		t.Fatal("expected an error for a failed fetch")
+	}
+	for range 2 {
+		chains, err := c.Chains(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(chains) != 1 || *chains[0].Slug != "eth" {
+			t.Fatalf("unexpected chains: %+v", chains)
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestNewClient_RetriesFailedRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[],"error":null}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(&server.URL, nil, WithMaxRetries(1), WithRetryMaxWait(time.Millisecond))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := c.API.ChainsWithResponse(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode() != http.StatusOK || requests != 2 {
+		t.Errorf("expected status 200 after 2 requests, got %d after %d", resp.StatusCode(), requests)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ErrorKind classifies the errors returned by the QuickNode API.
+type ErrorKind string
+
+const (
+	ErrorKindUnknown      ErrorKind = "unknown"
+	ErrorKindNotFound     ErrorKind = "not_found"
+	ErrorKindUnauthorized ErrorKind = "unauthorized"
+	ErrorKindRateLimited  ErrorKind = "rate_limited"
+	ErrorKindValidation   ErrorKind = "validation"
+	ErrorKindConflict     ErrorKind = "conflict"
+	ErrorKindServer       ErrorKind = "server"
+)
+
+// requestIDHeaders are the response headers that may carry the ID of a request.
+var requestIDHeaders = []string{"X-Request-Id", "Cf-Ray"}
+
+// maxErrorBodyLength bounds how much of a body that isn't an error envelope is kept as the message.
+const maxErrorBodyLength = 512
+
+// errorHints are the remediation hints shown for each kind of error.
+var errorHints = map[ErrorKind]string{
+	ErrorKindNotFound: "The object was not found. It may have been deleted outside of Terraform, or its ID may be wrong.",
+	ErrorKindUnauthorized: "The API key was rejected or lacks access to this object. Check the api_key provider attribute " +
+		"or the QUICKNODE_API_KEY environment variable, and the permissions of the key in the QuickNode dashboard.",
+	ErrorKindRateLimited: "The rate limit of the account was reached. Lower requests_per_second or max_concurrent_requests, " +
+		"or raise max_retries in the provider configuration.",
+	ErrorKindValidation: "The API rejected the request. Check the arguments of the resource or data source against the message above.",
+	ErrorKindConflict: "The request conflicts with the current state of the object, which may have been changed outside of Terraform. " +
+		"Refresh the state or import the existing object.",
+	ErrorKindServer: "The QuickNode API failed to handle the request. Try again later, and contact QuickNode support " +
+		"with the request ID if the error persists.",
+}
+
+// APIError is an error response of the QuickNode API.
+type APIError struct {
+	StatusCode int
+	Kind       ErrorKind
+	// Message is the error message of the response, or its raw body when
+	// it isn't an error envelope.
+	Message string
+	// RequestID identifies the request for QuickNode support, when known.
+	RequestID string
+}
+
+// NewAPIError parses the error envelope of a response.
+func NewAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{}
+	if resp != nil {
+		e.StatusCode = resp.StatusCode
+		for _, header := range requestIDHeaders {
+			if id := resp.Header.Get(header); id != "" {
+				e.RequestID = id
+				break
+			}
+		}
+	}
+	e.Kind = classifyStatus(e.StatusCode)
+
+	var envelope struct {
+		Error     json.RawMessage `json:"error"`
+		Errors    json.RawMessage `json:"errors"`
+		Message   string          `json:"message"`
+		RequestID string          `json:"request_id"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		e.Message = firstNonEmpty(errorMessage(envelope.Error), errorMessage(envelope.Errors), envelope.Message)
+		if e.RequestID == "" {
+			e.RequestID = envelope.RequestID
+		}
+	} else {
+		e.Message = strings.TrimSpace(string(body))
+		if len(e.Message) > maxErrorBodyLength {
+			e.Message = e.Message[:maxErrorBodyLength] + "..."
+		}
+	}
+	if e.Message == "" {
+		e.Message = http.StatusText(e.StatusCode)
+	}
+
+	return e
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("status %d: %s", e.StatusCode, e.Message)
+	if e.RequestID != "" {
+		msg += " (request ID: " + e.RequestID + ")"
+	}
+	return msg
+}
+
+// IsNotFound reports whether err is, or wraps, an API error for a missing object.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Kind == ErrorKindNotFound
+}
+
+// ErrorDiagnostic turns err into an error diagnostic. API errors get their
+// status, message, request ID and a remediation hint on separate lines, so
+// they stay readable in CI logs.
+func ErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return diag.NewErrorDiagnostic(summary, err.Error())
+	}
+
+	detail := fmt.Sprintf("The QuickNode API returned status %d: %s", apiErr.StatusCode, apiErr.Message)
+	if err != error(apiErr) {
+		// Keep the context of wrapped errors, but show the request ID on its own line.
+		detail = strings.TrimSuffix(err.Error(), " (request ID: "+apiErr.RequestID+")")
+	}
+	if apiErr.RequestID != "" {
+		detail += "\n\nRequest ID: " + apiErr.RequestID
+	}
+	if hint, ok := errorHints[apiErr.Kind]; ok {
+		detail += "\n\n" + hint
+	}
+	return diag.NewErrorDiagnostic(summary, detail)
+}
+
+// classifyStatus returns the kind of error of an HTTP status.
+func classifyStatus(status int) ErrorKind {
+	switch {
+	case status == http.StatusNotFound:
+		return ErrorKindNotFound
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrorKindUnauthorized
+	case status == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case status == http.StatusBadRequest || status == http.StatusUnprocessableEntity:
+		return ErrorKindValidation
+	case status == http.StatusConflict:
+		return ErrorKindConflict
+	case status >= http.StatusInternalServerError:
+		return ErrorKindServer
+	}
+	return ErrorKindUnknown
+}
+
+// errorMessage extracts the message of an error field, which is either a
+// string, an object with a message, or a list of either.
+func errorMessage(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+
+	var obj struct {
+		Message string `json:"message"`
+		Detail  string `json:"detail"`
+	}
+	if json.Unmarshal(raw, &obj) == nil {
+		return firstNonEmpty(obj.Message, obj.Detail)
+	}
+
+	var list []json.RawMessage
+	if json.Unmarshal(raw, &list) == nil {
+		var messages []string
+		for _, item := range list {
+			if msg := errorMessage(item); msg != "" {
+				messages = append(messages, msg)
+			}
+		}
+		return strings.Join(messages, "; ")
+	}
+
+	return ""
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
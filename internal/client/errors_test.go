@@ -0,0 +1,172 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// newErrorResponse returns a response with the status and headers.
+func newErrorResponse(status int, headers map[string]string) *http.Response {
+	resp := &http.Response{StatusCode: status, Header: http.Header{}}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		headers       map[string]string
+		body          string
+		wantKind      ErrorKind
+		wantMessage   string
+		wantRequestID string
+	}{
+		{
+			name:        "error string",
+			status:      http.StatusBadRequest,
+			body:        `{"error":"label is too long"}`,
+			wantKind:    ErrorKindValidation,
+			wantMessage: "label is too long",
+		},
+		{
+			name:        "error object",
+			status:      http.StatusConflict,
+			body:        `{"error":{"message":"tag already exists"}}`,
+			wantKind:    ErrorKindConflict,
+			wantMessage: "tag already exists",
+		},
+		{
+			name:        "errors list",
+			status:      http.StatusUnprocessableEntity,
+			body:        `{"errors":[{"message":"chain is invalid"},"network is invalid"]}`,
+			wantKind:    ErrorKindValidation,
+			wantMessage: "chain is invalid; network is invalid",
+		},
+		{
+			name:          "message with request ID in body",
+			status:        http.StatusForbidden,
+			body:          `{"message":"forbidden","request_id":"body-id"}`,
+			wantKind:      ErrorKindUnauthorized,
+			wantMessage:   "forbidden",
+			wantRequestID: "body-id",
+		},
+		{
+			name:          "request ID header wins over body",
+			status:        http.StatusNotFound,
+			headers:       map[string]string{"X-Request-Id": "header-id"},
+			body:          `{"error":"not found","request_id":"body-id"}`,
+			wantKind:      ErrorKindNotFound,
+			wantMessage:   "not found",
+			wantRequestID: "header-id",
+		},
+		{
+			name:          "raw body",
+			status:        http.StatusBadGateway,
+			headers:       map[string]string{"Cf-Ray": "ray-id"},
+			body:          "<html>bad gateway</html>\n",
+			wantKind:      ErrorKindServer,
+			wantMessage:   "<html>bad gateway</html>",
+			wantRequestID: "ray-id",
+		},
+		{
+			name:        "empty body",
+			status:      http.StatusTooManyRequests,
+			wantKind:    ErrorKindRateLimited,
+			wantMessage: "Too Many Requests",
+		},
+		{
+			name:        "unclassified status",
+			status:      http.StatusTeapot,
+			body:        `{}`,
+			wantKind:    ErrorKindUnknown,
+			wantMessage: "I'm a teapot",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewAPIError(newErrorResponse(tt.status, tt.headers), []byte(tt.body))
+			if err.StatusCode != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, err.StatusCode)
+			}
+			if err.Kind != tt.wantKind {
+				t.Errorf("expected kind %q, got %q", tt.wantKind, err.Kind)
+			}
+			if err.Message != tt.wantMessage {
+				t.Errorf("expected message %q, got %q", tt.wantMessage, err.Message)
+			}
+			if err.RequestID != tt.wantRequestID {
+				t.Errorf("expected request ID %q, got %q", tt.wantRequestID, err.RequestID)
+			}
+		})
+	}
+}
+
+func TestNewAPIError_TruncatesLongBodies(t *testing.T) {
+	err := NewAPIError(newErrorResponse(http.StatusInternalServerError, nil), []byte(strings.Repeat("x", 2*maxErrorBodyLength)))
+	if len(err.Message) != maxErrorBodyLength+len("...") {
+		t.Errorf("expected message of %d bytes, got %d", maxErrorBodyLength+len("..."), len(err.Message))
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	err := NewAPIError(newErrorResponse(http.StatusNotFound, map[string]string{"X-Request-Id": "abc"}), []byte(`{"error":"endpoint not found"}`))
+	if want := "status 404: endpoint not found (request ID: abc)"; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := NewAPIError(newErrorResponse(http.StatusNotFound, nil), nil)
+	serverError := NewAPIError(newErrorResponse(http.StatusInternalServerError, nil), nil)
+
+	if !IsNotFound(notFound) {
+		t.Error("expected a 404 to be not found")
+	}
+	if !IsNotFound(fmt.Errorf("reading endpoint: %w", notFound)) {
+		t.Error("expected a wrapped 404 to be not found")
+	}
+	if IsNotFound(serverError) {
+		t.Error("expected a 500 not to be not found")
+	}
+	if IsNotFound(errors.New("status 404")) {
+		t.Error("expected a plain error not to be not found")
+	}
+}
+
+func TestErrorDiagnostic(t *testing.T) {
+	apiErr := NewAPIError(newErrorResponse(http.StatusUnauthorized, map[string]string{"X-Request-Id": "abc"}), []byte(`{"error":"invalid API key"}`))
+
+	d := ErrorDiagnostic("Error Reading QuickNode Endpoint", apiErr)
+	if d.Summary() != "Error Reading QuickNode Endpoint" {
+		t.Errorf("unexpected summary %q", d.Summary())
+	}
+	for _, want := range []string{
+		"The QuickNode API returned status 401: invalid API key",
+		"Request ID: abc",
+		errorHints[ErrorKindUnauthorized],
+	} {
+		if !strings.Contains(d.Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, d.Detail())
+		}
+	}
+
+	d = ErrorDiagnostic("Error Updating QuickNode Endpoint", fmt.Errorf("updating endpoint status: %w", apiErr))
+	if want := "updating endpoint status: status 401: invalid API key\n\nRequest ID: abc"; !strings.HasPrefix(d.Detail(), want) {
+		t.Errorf("expected detail to start with %q, got %q", want, d.Detail())
+	}
+
+	d = ErrorDiagnostic("Error Reading QuickNode Endpoint", errors.New("connection refused"))
+	if d.Detail() != "connection refused" {
+		t.Errorf("expected the error as detail, got %q", d.Detail())
+	}
+}
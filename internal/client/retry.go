@@ -0,0 +1,210 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried by default.
+	DefaultMaxRetries = 3
+	// MaxRetriesLimit is the largest accepted number of retries of a request.
+	MaxRetriesLimit = 20
+	// DefaultRetryMaxWait is the longest wait between two attempts by default.
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryMinWait is the base of the exponential backoff.
+	retryMinWait = 1 * time.Second
+	// attemptTimeout bounds a single attempt of a request.
+	attemptTimeout = 10 * time.Second
+)
+
+// retryTransport retries requests that failed with a transport error, a 429
+// or a 5xx response. Safe and idempotent requests are retried on any of these
+// failures, other requests only on a 429, as the API rejected them without
+// processing them.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+
+	// sleep waits for d or until ctx is done. It is replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newRetryTransport wraps next with retries.
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxWait time.Duration) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		maxWait:    maxWait,
+		sleep:      sleepContext,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Debug(req.Context(), "Retrying QuickNode API request", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"status":  statusOf(resp),
+		})
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+			_ = resp.Body.Close()
+		}
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry reports whether the attempt failed in a way worth retrying.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// Never retry once the caller gave up.
+	if req.Context().Err() != nil {
+		return false
+	}
+	// A request whose body can't be replayed can't be retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(req.Method) {
+		return false
+	}
+	return err != nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns how long to wait before the next attempt: the Retry-After
+// of the response when set, jittered exponential backoff otherwise, capped
+// at maxWait either way.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+
+	// Stop doubling once the cap is reached, before the shift overflows.
+	base := t.maxWait
+	if attempt < 30 && retryMinWait<<attempt < t.maxWait {
+		base = retryMinWait << attempt
+	}
+	return base/2 + rand.N(base/2+1)
+}
+
+// isIdempotent reports whether requests with the method can safely be sent twice.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// statusOf returns the status code of resp, or 0 when the attempt failed without a response.
+func statusOf(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// timeoutTransport bounds each attempt of a request with its own timeout, so
+// retries get their own time budget. It sits below the throttle, so waiting
+// for the throttle doesn't count against the timeout.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// newTimeoutTransport wraps next with a timeout per attempt.
+func newTimeoutTransport(next http.RoundTripper, timeout time.Duration) *timeoutTransport {
+	return &timeoutTransport{next: next, timeout: timeout}
+}
+
+// RoundTrip implements http.RoundTripper. The timeout is released once the
+// response body is closed.
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases the context of an attempt when the body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and releases the context.
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
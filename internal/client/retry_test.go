@@ -0,0 +1,194 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestRetryClient returns a client retrying through a transport whose
+// waits are recorded instead of slept.
+func newTestRetryClient(maxRetries int, maxWait time.Duration, waits *[]time.Duration) *http.Client {
+	transport := newRetryTransport(http.DefaultTransport, maxRetries, maxWait)
+	transport.sleep = func(_ context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	return &http.Client{Transport: transport}
+}
+
+// newStatusServer responds with the given statuses in order, then 200.
+func newStatusServer(t *testing.T, statuses []int, headers http.Header, bodies *[]string) *httptest.Server {
+	t.Helper()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*bodies = append(*bodies, string(body))
+
+		status := http.StatusOK
+		if requests < len(statuses) {
+			status = statuses[requests]
+		}
+		requests++
+		for k, v := range headers {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRetryTransport_RetriesIdempotentRequests(t *testing.T) {
+	var waits []time.Duration
+	var bodies []string
+	server := newStatusServer(t, []int{http.StatusServiceUnavailable, http.StatusBadGateway}, nil, &bodies)
+
+	resp, err := newTestRetryClient(3, time.Minute, &waits).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if len(bodies) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(bodies))
+	}
+	if len(waits) != 2 {
+		t.Fatalf("expected 2 waits, got %d", len(waits))
+	}
+	if waits[0] < retryMinWait/2 || waits[0] > retryMinWait || waits[1] < retryMinWait || waits[1] > 2*retryMinWait {
+		t.Errorf("expected jittered exponential waits, got %v", waits)
+	}
+}
+
+func TestRetryTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	var waits []time.Duration
+	var bodies []string
+	server := newStatusServer(t, []int{500, 500, 500, 500}, nil, &bodies)
+
+	resp, err := newTestRetryClient(2, time.Minute, &waits).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", resp.StatusCode)
+	}
+	if len(bodies) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(bodies))
+	}
+}
+
+func TestRetryTransport_DoesNotRetryNonIdempotentServerErrors(t *testing.T) {
+	var waits []time.Duration
+	var bodies []string
+	server := newStatusServer(t, []int{http.StatusBadGateway}, nil, &bodies)
+
+	resp, err := newTestRetryClient(3, time.Minute, &waits).Post(server.URL, "application/json", strings.NewReader(`{"chain":"eth"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || len(bodies) != 1 {
+		t.Errorf("expected a single failed request, got status %d after %d requests", resp.StatusCode, len(bodies))
+	}
+}
+
+func TestRetryTransport_RetriesNonIdempotentTooManyRequests(t *testing.T) {
+	var waits []time.Duration
+	var bodies []string
+	server := newStatusServer(t, []int{http.StatusTooManyRequests}, http.Header{"Retry-After": {"7"}}, &bodies)
+
+	resp, err := newTestRetryClient(3, time.Minute, &waits).Post(server.URL, "application/json", strings.NewReader(`{"chain":"eth"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"chain":"eth"}` {
+		t.Errorf("expected the body to be replayed, got %q", bodies)
+	}
+	if len(waits) != 1 || waits[0] != 7*time.Second {
+		t.Errorf("expected to wait for the Retry-After, got %v", waits)
+	}
+}
+
+func TestRetryTransport_CapsRetryAfter(t *testing.T) {
+	var waits []time.Duration
+	var bodies []string
+	server := newStatusServer(t, []int{http.StatusTooManyRequests}, http.Header{"Retry-After": {"3600"}}, &bodies)
+
+	resp, err := newTestRetryClient(3, 5*time.Second, &waits).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if len(waits) != 1 || waits[0] != 5*time.Second {
+		t.Errorf("expected the wait to be capped at 5s, got %v", waits)
+	}
+}
+
+func TestRetryTransport_BackoffLargeAttempts(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 100, 30*time.Second)
+
+	for _, attempt := range []int{5, 30, 33, 34, 62, 63, 64, 100} {
+		if got := transport.backoff(attempt, nil); got < 15*time.Second || got > 30*time.Second {
+			t.Errorf("attempt %d: expected a wait between 15s and 30s, got %s", attempt, got)
+		}
+	}
+}
+
+func TestRetryTransport_Disabled(t *testing.T) {
+	var waits []time.Duration
+	var bodies []string
+	server := newStatusServer(t, []int{http.StatusServiceUnavailable}, nil, &bodies)
+
+	resp, err := newTestRetryClient(0, time.Minute, &waits).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable || len(bodies) != 1 {
+		t.Errorf("expected a single failed request, got status %d after %d requests", resp.StatusCode, len(bodies))
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"Thu, 02 May 2024 12:00:30 GMT", 30 * time.Second, true},
+		{"Thu, 02 May 2024 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q): expected (%s, %t), got (%s, %t)", tt.value, tt.want, tt.ok, got, ok)
+		}
+	}
+}
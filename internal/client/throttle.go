@@ -0,0 +1,157 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultMaxConcurrentRequests is the number of requests in flight at once by default.
+const DefaultMaxConcurrentRequests = 10
+
+// throttleTransport limits the rate of requests with a token bucket and the
+// number of requests in flight with a semaphore. A single transport is shared
+// by every resource and data source of a configured provider.
+type throttleTransport struct {
+	next http.RoundTripper
+
+	// bucket is nil when the request rate is unlimited.
+	bucket *tokenBucket
+	// slots is nil when the number of requests in flight is unlimited.
+	slots chan struct{}
+
+	// sleep waits for d or until ctx is done. It is replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newThrottleTransport wraps next with a limit of requestsPerSecond requests
+// per second and maxConcurrent requests in flight. Zero disables a limit.
+func newThrottleTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrent int) *throttleTransport {
+	t := &throttleTransport{
+		next:  next,
+		sleep: sleepContext,
+	}
+	if requestsPerSecond > 0 {
+		t.bucket = newTokenBucket(requestsPerSecond, time.Now)
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.bucket != nil {
+		if wait := t.bucket.reserve(); wait > 0 {
+			tflog.Trace(ctx, "Throttling QuickNode API request", map[string]interface{}{
+				"method": req.Method,
+				"path":   req.URL.Path,
+				"wait":   wait.String(),
+			})
+			if err := t.sleep(ctx, wait); err != nil {
+				t.bucket.cancel()
+				t.release()
+				return nil, err
+			}
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.release()
+		return nil, err
+	}
+
+	// Keep the slot until the response is read.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: t.release}
+	return resp, nil
+}
+
+// release frees the slot of a request.
+func (t *throttleTransport) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+// releaseOnClose frees the slot of a request once its body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close closes the body and frees the slot.
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// tokenBucket hands out rate tokens per second, with bursts of up to one
+// second worth of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket returns a full bucket refilled at rate tokens per second.
+func newTokenBucket(rate float64, now func() time.Time) *tokenBucket {
+	burst := math.Max(1, math.Floor(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   now(),
+		now:    now,
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+// Tokens are reserved in order, so waiting requests are served first come,
+// first served.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that wasn't used.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
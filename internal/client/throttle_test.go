@@ -0,0 +1,167 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_Reserve(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(2, func() time.Time { return now })
+
+	// The full bucket allows a burst of 2 requests, then queues the next ones.
+	for i, want := range []time.Duration{0, 0, 500 * time.Millisecond, time.Second} {
+		if got := bucket.reserve(); got != want {
+			t.Errorf("reservation %d: expected a wait of %s, got %s", i, want, got)
+		}
+	}
+
+	// A second later, the 2 queued requests have been served.
+	now = now.Add(time.Second)
+	if got := bucket.reserve(); got != 500*time.Millisecond {
+		t.Errorf("expected a wait of 500ms, got %s", got)
+	}
+}
+
+func TestTokenBucket_Cancel(t *testing.T) {
+	now := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	bucket := newTokenBucket(1, func() time.Time { return now })
+
+	bucket.reserve()
+	if got := bucket.reserve(); got != time.Second {
+		t.Fatalf("expected a wait of 1s, got %s", got)
+	}
+	bucket.cancel()
+	if got := bucket.reserve(); got != time.Second {
+		t.Errorf("expected the cancelled token to be reused, got a wait of %s", got)
+	}
+}
+
+func TestThrottleTransport_RateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	transport := newThrottleTransport(http.DefaultTransport, 1, 0)
+	transport.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	for range 3 {
+		resp, err := httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(waits) != 2 {
+		t.Fatalf("expected 2 throttled requests, got %d", len(waits))
+	}
+	if waits[1] <= waits[0] {
+		t.Errorf("expected queued requests to wait longer, got %v", waits)
+	}
+}
+
+func TestThrottleTransport_ConcurrencyCap(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: newThrottleTransport(http.DefaultTransport, 0, 2)}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestThrottleTransport_CancelledWhileQueued(t *testing.T) {
+	transport := newThrottleTransport(http.DefaultTransport, 0, 1)
+	transport.slots <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+
+	if _, err := transport.RoundTrip(req); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestThrottleTransport_ThrottlesRetries(t *testing.T) {
+	var bodies []string
+	server := newStatusServer(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}, nil, &bodies)
+
+	var throttleWaits, retryWaits []time.Duration
+	throttle := newThrottleTransport(http.DefaultTransport, 1, 1)
+	throttle.sleep = func(_ context.Context, d time.Duration) error {
+		throttleWaits = append(throttleWaits, d)
+		return nil
+	}
+	retry := newRetryTransport(throttle, 2, time.Second)
+	retry.sleep = func(_ context.Context, d time.Duration) error {
+		// The slot of the failed attempt is free while backing off.
+		if len(throttle.slots) != 0 {
+			t.Errorf("expected no slot held while backing off, got %d", len(throttle.slots))
+		}
+		retryWaits = append(retryWaits, d)
+		return nil
+	}
+	httpClient := &http.Client{Transport: retry}
+
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if len(retryWaits) != 2 {
+		t.Errorf("expected 2 retries, got %d", len(retryWaits))
+	}
+	// The first attempt takes the only token of the bucket, so both retries
+	// wait for a token.
+	if len(throttleWaits) != 2 {
+		t.Errorf("expected 2 throttled retries, got %d", len(throttleWaits))
+	}
+}
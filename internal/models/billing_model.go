@@ -0,0 +1,47 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Terraform Models.
+type InvoicesDataSourceModel struct {
+	StartTime       types.String   `tfsdk:"start_time"`
+	EndTime         types.String   `tfsdk:"end_time"`
+	Status          types.String   `tfsdk:"status"`
+	TotalAmountDue  types.Int64    `tfsdk:"total_amount_due"`
+	TotalAmountPaid types.Int64    `tfsdk:"total_amount_paid"`
+	Invoices        []InvoiceModel `tfsdk:"invoices"`
+}
+
+type InvoiceModel struct {
+	ID            types.String       `tfsdk:"id"`
+	Status        types.String       `tfsdk:"status"`
+	BillingReason types.String       `tfsdk:"billing_reason"`
+	AmountDue     types.Int64        `tfsdk:"amount_due"`
+	AmountPaid    types.Int64        `tfsdk:"amount_paid"`
+	Subtotal      types.Int64        `tfsdk:"subtotal"`
+	Created       types.String       `tfsdk:"created"`
+	PeriodStart   types.String       `tfsdk:"period_start"`
+	PeriodEnd     types.String       `tfsdk:"period_end"`
+	Lines         []InvoiceLineModel `tfsdk:"lines"`
+}
+
+type InvoiceLineModel struct {
+	Description types.String `tfsdk:"description"`
+	Amount      types.Int64  `tfsdk:"amount"`
+}
+
+type PaymentsDataSourceModel struct {
+	StartTime types.String   `tfsdk:"start_time"`
+	EndTime   types.String   `tfsdk:"end_time"`
+	Status    types.String   `tfsdk:"status"`
+	Payments  []PaymentModel `tfsdk:"payments"`
+}
+
+type PaymentModel struct {
+	Amount            types.String `tfsdk:"amount"`
+	Currency          types.String `tfsdk:"currency"`
+	Status            types.String `tfsdk:"status"`
+	CardLast4         types.String `tfsdk:"card_last_4"`
+	MarketplaceAmount types.Int64  `tfsdk:"marketplace_amount"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
@@ -22,22 +22,31 @@ type EndpointResourceModel struct {
 	HTTPURL         types.String                  `tfsdk:"http_url"`
 	WSSURL          types.String                  `tfsdk:"wss_url"`
 	SecurityOptions *SecurityOptionsResourceModel `tfsdk:"security_options"`
+	IPCustomHeader  types.String                  `tfsdk:"ip_custom_header"`
 	Status          types.String                  `tfsdk:"status"`
 	Tags            types.List                    `tfsdk:"tags"` // element type: types.StringType
 	Multichain      types.Bool                    `tfsdk:"multichain"`
 }
 
 type EndpointsDataSourceModel struct {
-	Limit     types.Int64 `tfsdk:"limit"`
-	Offset    types.Int64 `tfsdk:"offset"`
-	Endpoints []struct {
-		ID      types.String `tfsdk:"id"`
-		Label   types.String `tfsdk:"label"`
-		Chain   types.String `tfsdk:"chain"`
-		Network types.String `tfsdk:"network"`
-		HTTPURL types.String `tfsdk:"http_url"`
-		WSSURL  types.String `tfsdk:"wss_url"`
-	} `tfsdk:"endpoints"`
+	Limit     types.Int64              `tfsdk:"limit"`
+	Offset    types.Int64              `tfsdk:"offset"`
+	All       types.Bool               `tfsdk:"all"`
+	TagLabels types.Set                `tfsdk:"tag_labels"` // element type: types.StringType
+	TagIDs    types.Set                `tfsdk:"tag_ids"`    // element type: types.Int64Type
+	Chain     types.String             `tfsdk:"chain"`
+	Network   types.String             `tfsdk:"network"`
+	Status    types.String             `tfsdk:"status"`
+	Endpoints []EndpointsEndpointModel `tfsdk:"endpoints"`
+}
+
+type EndpointsEndpointModel struct {
+	ID      types.String `tfsdk:"id"`
+	Label   types.String `tfsdk:"label"`
+	Chain   types.String `tfsdk:"chain"`
+	Network types.String `tfsdk:"network"`
+	HTTPURL types.String `tfsdk:"http_url"`
+	WSSURL  types.String `tfsdk:"wss_url"`
 }
 
 type EndpointWhitelistIPResourceModel struct {
@@ -57,3 +66,80 @@ type EndpointWhitelistMethodsResourceModel struct {
 	Method     types.Set    `tfsdk:"method"` // element type: types.StringType
 	EndpointID types.String `tfsdk:"endpoint_id"`
 }
+
+type EndpointReferrerResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Referrer   types.String `tfsdk:"referrer"`
+	EndpointID types.String `tfsdk:"endpoint_id"`
+}
+
+type EndpointJWTResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	PublicKey          types.String `tfsdk:"public_key"`
+	PublicKeyWO        types.String `tfsdk:"public_key_wo"`
+	PublicKeyWOVersion types.Int64  `tfsdk:"public_key_wo_version"`
+	Kid                types.String `tfsdk:"kid"`
+	EndpointID         types.String `tfsdk:"endpoint_id"`
+}
+
+type EndpointTokenResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	EndpointID types.String `tfsdk:"endpoint_id"`
+	Token      types.String `tfsdk:"token"`
+	Keepers    types.Map    `tfsdk:"keepers"` // element type: types.StringType
+}
+
+type EndpointTokenEphemeralResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EndpointID    types.String `tfsdk:"endpoint_id"`
+	TokenID       types.String `tfsdk:"token_id"`
+	RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	Token         types.String `tfsdk:"token"`
+	HTTPURL       types.String `tfsdk:"http_url"`
+	WSSURL        types.String `tfsdk:"wss_url"`
+}
+
+type EndpointRateLimitsResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EndpointID    types.String `tfsdk:"endpoint_id"`
+	RPS           types.Int64  `tfsdk:"rps"`
+	RPM           types.Int64  `tfsdk:"rpm"`
+	RPD           types.Int64  `tfsdk:"rpd"`
+	RateLimitByIP types.Bool   `tfsdk:"rate_limit_by_ip"`
+	Account       types.Int64  `tfsdk:"account"`
+}
+
+type EndpointMethodRateLimitResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	EndpointID types.String `tfsdk:"endpoint_id"`
+	Interval   types.String `tfsdk:"interval"`
+	Methods    types.Set    `tfsdk:"methods"` // element type: types.StringType
+	Rate       types.Int64  `tfsdk:"rate"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+type EndpointLogsDataSourceModel struct {
+	EndpointID     types.String       `tfsdk:"endpoint_id"`
+	StartTime      types.String       `tfsdk:"start_time"`
+	EndTime        types.String       `tfsdk:"end_time"`
+	MaxRecords     types.Int64        `tfsdk:"max_records"`
+	IncludeDetails types.Bool         `tfsdk:"include_details"`
+	TotalCount     types.Int64        `tfsdk:"total_count"`
+	ErrorCount     types.Int64        `tfsdk:"error_count"`
+	NextAt         types.String       `tfsdk:"next_at"`
+	Logs           []EndpointLogModel `tfsdk:"logs"`
+}
+
+type EndpointLogModel struct {
+	Timestamp  types.String `tfsdk:"timestamp"`
+	RequestID  types.String `tfsdk:"request_id"`
+	Method     types.String `tfsdk:"method"`
+	Network    types.String `tfsdk:"network"`
+	HTTPMethod types.String `tfsdk:"http_method"`
+	URL        types.String `tfsdk:"url"`
+	Status     types.Int64  `tfsdk:"status"`
+	ErrorCode  types.Int64  `tfsdk:"error_code"`
+	Request    types.String `tfsdk:"request"`
+	Response   types.String `tfsdk:"response"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Terraform Models.
+type EndpointMetricsDataSourceModel struct {
+	EndpointID types.String        `tfsdk:"endpoint_id"`
+	Period     types.String        `tfsdk:"period"`
+	Metric     types.String        `tfsdk:"metric"`
+	Series     []MetricSeriesModel `tfsdk:"series"`
+}
+
+type AccountMetricsDataSourceModel struct {
+	Period     types.String        `tfsdk:"period"`
+	Metric     types.String        `tfsdk:"metric"`
+	Percentile types.String        `tfsdk:"percentile"`
+	Series     []MetricSeriesModel `tfsdk:"series"`
+}
+
+type MetricSeriesModel struct {
+	Tag    types.String       `tfsdk:"tag"`
+	Points []MetricPointModel `tfsdk:"points"`
+	Min    types.Int64        `tfsdk:"min"`
+	Max    types.Int64        `tfsdk:"max"`
+	Avg    types.Float64      `tfsdk:"avg"`
+	Last   types.Int64        `tfsdk:"last"`
+}
+
+type MetricPointModel struct {
+	Timestamp types.Int64  `tfsdk:"timestamp"`
+	Time      types.String `tfsdk:"time"`
+	Value     types.Int64  `tfsdk:"value"`
+}
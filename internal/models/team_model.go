@@ -0,0 +1,59 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Terraform Models.
+type TeamResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	DefaultRole  types.String `tfsdk:"default_role"`
+	MembersCount types.Int64  `tfsdk:"members_count"`
+}
+
+type TeamUserModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Email              types.String `tfsdk:"email"`
+	FullName           types.String `tfsdk:"full_name"`
+	Role               types.String `tfsdk:"role"`
+	Status             types.String `tfsdk:"status"`
+	AccountPrimaryUser types.Bool   `tfsdk:"account_primary_user"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+type TeamDataSourceModel struct {
+	ID             types.String    `tfsdk:"id"`
+	Name           types.String    `tfsdk:"name"`
+	DefaultRole    types.String    `tfsdk:"default_role"`
+	MembersCount   types.Int64     `tfsdk:"members_count"`
+	Users          []TeamUserModel `tfsdk:"users"`
+	PendingInvites []TeamUserModel `tfsdk:"pending_invites"`
+}
+
+type TeamsDataSourceModel struct {
+	Teams []TeamsTeamModel `tfsdk:"teams"`
+}
+
+type TeamsTeamModel struct {
+	ID           types.String    `tfsdk:"id"`
+	Name         types.String    `tfsdk:"name"`
+	MembersCount types.Int64     `tfsdk:"members_count"`
+	Users        []TeamUserModel `tfsdk:"users"`
+}
+
+type TeamMemberResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	TeamID        types.String `tfsdk:"team_id"`
+	Email         types.String `tfsdk:"email"`
+	FullName      types.String `tfsdk:"full_name"`
+	Role          types.String `tfsdk:"role"`
+	Status        types.String `tfsdk:"status"`
+	ResendTrigger types.String `tfsdk:"resend_trigger"`
+	DestroyUser   types.Bool   `tfsdk:"destroy_user"`
+}
+
+type TeamEndpointsResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	TeamID                types.String `tfsdk:"team_id"`
+	EndpointIDs           types.Set    `tfsdk:"endpoint_ids"`            // element type: types.StringType
+	AccessibleEndpointIDs types.Set    `tfsdk:"accessible_endpoint_ids"` // element type: types.StringType
+}
@@ -0,0 +1,43 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Terraform Models.
+type RPCUsageDataSourceModel struct {
+	StartTime        types.String         `tfsdk:"start_time"`
+	EndTime          types.String         `tfsdk:"end_time"`
+	GroupBy          types.String         `tfsdk:"group_by"`
+	WindowStart      types.String         `tfsdk:"window_start"`
+	WindowEnd        types.String         `tfsdk:"window_end"`
+	CreditsUsed      types.Int64          `tfsdk:"credits_used"`
+	CreditsRemaining types.Int64          `tfsdk:"credits_remaining"`
+	Limit            types.Int64          `tfsdk:"limit"`
+	Overages         types.Int64          `tfsdk:"overages"`
+	Requests         types.Int64          `tfsdk:"requests"`
+	ChainUsage       []ChainUsageModel    `tfsdk:"chain_usage"`
+	EndpointUsage    []EndpointUsageModel `tfsdk:"endpoint_usage"`
+	MethodUsage      []MethodUsageModel   `tfsdk:"method_usage"`
+}
+
+type ChainUsageModel struct {
+	Name        types.String `tfsdk:"name"`
+	CreditsUsed types.Int64  `tfsdk:"credits_used"`
+}
+
+type EndpointUsageModel struct {
+	Name        types.String `tfsdk:"name"`
+	Label       types.String `tfsdk:"label"`
+	Chain       types.String `tfsdk:"chain"`
+	Network     types.String `tfsdk:"network"`
+	Status      types.String `tfsdk:"status"`
+	CreditsUsed types.Int64  `tfsdk:"credits_used"`
+	Requests    types.Int64  `tfsdk:"requests"`
+}
+
+type MethodUsageModel struct {
+	MethodName  types.String `tfsdk:"method_name"`
+	Chain       types.String `tfsdk:"chain"`
+	Network     types.String `tfsdk:"network"`
+	Archive     types.Bool   `tfsdk:"archive"`
+	CreditsUsed types.Int64  `tfsdk:"credits_used"`
+}
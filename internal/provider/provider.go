@@ -5,23 +5,34 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/service/billing"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/service/chains"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/service/endpoints"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/service/metrics"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/service/teams"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/service/usage"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &quicknodeProvider{}
+	_ provider.Provider                       = &quicknodeProvider{}
+	_ provider.ProviderWithEphemeralResources = &quicknodeProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -43,8 +54,12 @@ type quicknodeProvider struct {
 
 // quicknodeProviderModel maps provider schema data to a Go type.
 type quicknodeProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ApiKey   types.String `tfsdk:"api_key"`
+	Endpoint              types.String  `tfsdk:"endpoint"`
+	ApiKey                types.String  `tfsdk:"api_key"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait          types.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 // Metadata returns the provider type name.
@@ -67,9 +82,40 @@ func (p *quicknodeProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Description: "The endpoint to use for the QuickNode API. Can also be set with the `QUICKNODE_ENDPOINT` environment variable.",
 			},
 			"api_key": schema.StringAttribute{
-				Optional:    true,
-				Sensitive:   true,
-				Description: "The API key to use for the QuickNode API. Can also be set with the `QUICKNODE_API_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Description: "The API key to use for the QuickNode API. Can also be set with the `QUICKNODE_API_KEY` environment variable. " +
+					"The provider configuration is never stored in the state, but prefer the environment variable to keep the key out of the configuration files.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("How many times a request that failed with a 429 or 5xx response or a network error is retried. "+
+					"Requests that create or update objects are only retried on a 429. Set to `0` to disable retries. At most `%d`, defaults to `%d`.",
+					client.MaxRetriesLimit, client.DefaultMaxRetries),
+				Validators: []validator.Int64{
+					int64validator.Between(0, client.MaxRetriesLimit),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The longest wait between two attempts of a request, as a duration such as `30s` or `2m`. "+
+					"Caps both the exponential backoff and the `Retry-After` header of the API. Defaults to `%s`.", client.DefaultRetryMaxWait),
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				Description: "The most requests sent to the QuickNode API per second, shared by all resources and data sources of the provider. " +
+					"Set it below the rate limit of your account to avoid 429 responses with high parallelism. Defaults to no limit.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("The most requests to the QuickNode API in flight at once, shared by all resources and data sources of the provider. "+
+					"Set to `0` for no limit. Defaults to `%d`.", client.DefaultMaxConcurrentRequests),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 		},
 	}
@@ -145,8 +191,32 @@ func (p *quicknodeProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	opts := []client.Option{}
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		opts = append(opts, client.WithMaxRetries(int(config.MaxRetries.ValueInt64())))
+	}
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		retryMaxWait, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil || retryMaxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("The retry_max_wait value %q must be a positive duration such as \"30s\" or \"2m\".", config.RetryMaxWait.ValueString()),
+			)
+			return
+		}
+		opts = append(opts, client.WithRetryMaxWait(retryMaxWait))
+	}
+
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		opts = append(opts, client.WithRequestsPerSecond(config.RequestsPerSecond.ValueFloat64()))
+	}
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		opts = append(opts, client.WithMaxConcurrentRequests(int(config.MaxConcurrentRequests.ValueInt64())))
+	}
+
 	// Create a new QuickNode client using the configuration values
-	client, err := client.NewClient(&endpoint, &apiKey)
+	client, err := client.NewClient(&endpoint, &apiKey, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create QuickNode API Client",
@@ -157,10 +227,11 @@ func (p *quicknodeProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Make the QuickNode client available during DataSource and Resource
+	// Make the QuickNode client available during DataSource, Resource and EphemeralResource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -169,6 +240,14 @@ func (p *quicknodeProvider) DataSources(_ context.Context) []func() datasource.D
 		chains.NewChainsDataSource,
 		endpoints.NewEndpointDataSource,
 		endpoints.NewEndpointsDataSource,
+		endpoints.NewEndpointLogsDataSource,
+		metrics.NewEndpointMetricsDataSource,
+		metrics.NewAccountMetricsDataSource,
+		teams.NewTeamDataSource,
+		teams.NewTeamsDataSource,
+		usage.NewRPCUsageDataSource,
+		billing.NewInvoicesDataSource,
+		billing.NewPaymentsDataSource,
 	}
 }
 
@@ -179,5 +258,20 @@ func (p *quicknodeProvider) Resources(_ context.Context) []func() resource.Resou
 		endpoints.NewEndpointWhitelistIPResource,
 		endpoints.NewEndpointWhitelistMethodsResource,
 		endpoints.NewEndpointWhitelistDomainMaskResource,
+		endpoints.NewEndpointReferrerResource,
+		endpoints.NewEndpointJWTResource,
+		endpoints.NewEndpointTokenResource,
+		endpoints.NewEndpointRateLimitsResource,
+		endpoints.NewEndpointMethodRateLimitResource,
+		teams.NewTeamResource,
+		teams.NewTeamMemberResource,
+		teams.NewTeamEndpointsResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *quicknodeProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		endpoints.NewEndpointTokenEphemeralResource,
 	}
 }
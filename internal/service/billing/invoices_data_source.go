@@ -0,0 +1,232 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &invoicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &invoicesDataSource{}
+)
+
+// NewInvoicesDataSource is a helper function to simplify the provider implementation.
+func NewInvoicesDataSource() datasource.DataSource {
+	return &invoicesDataSource{}
+}
+
+// invoicesDataSource is the data source implementation.
+type invoicesDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *invoicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoices"
+}
+
+// Schema defines the schema for the data source.
+func (d *invoicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := timeWindowAttributes("invoices")
+	attributes["status"] = schema.StringAttribute{
+		Description: "Only return invoices with this status, such as `paid` or `open`.",
+		Optional:    true,
+	}
+	attributes["total_amount_due"] = schema.Int64Attribute{
+		Description: "The sum of `amount_due` over the returned invoices.",
+		Computed:    true,
+	}
+	attributes["total_amount_paid"] = schema.Int64Attribute{
+		Description: "The sum of `amount_paid` over the returned invoices.",
+		Computed:    true,
+	}
+	attributes["invoices"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "A unique identifier for the invoice.",
+					Computed:    true,
+				},
+				"status": schema.StringAttribute{
+					Description: "The status of the invoice.",
+					Computed:    true,
+				},
+				"billing_reason": schema.StringAttribute{
+					Description: "The reason the invoice was created.",
+					Computed:    true,
+				},
+				"amount_due": schema.Int64Attribute{
+					Description: "The amount due on the invoice, in cents.",
+					Computed:    true,
+				},
+				"amount_paid": schema.Int64Attribute{
+					Description: "The amount paid on the invoice, in cents.",
+					Computed:    true,
+				},
+				"subtotal": schema.Int64Attribute{
+					Description: "The subtotal of the invoice, in cents.",
+					Computed:    true,
+				},
+				"created": schema.StringAttribute{
+					Description: "When the invoice was created, as an RFC3339 timestamp.",
+					Computed:    true,
+				},
+				"period_start": schema.StringAttribute{
+					Description: "The start of the billing period of the invoice, as an RFC3339 timestamp.",
+					Computed:    true,
+				},
+				"period_end": schema.StringAttribute{
+					Description: "The end of the billing period of the invoice, as an RFC3339 timestamp.",
+					Computed:    true,
+				},
+				"lines": schema.ListNestedAttribute{
+					Description: "The line items of the invoice.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"description": schema.StringAttribute{
+								Description: "The description of the line item.",
+								Computed:    true,
+							},
+							"amount": schema.Int64Attribute{
+								Description: "The amount of the line item, in cents.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the invoices of the account, optionally filtered by creation time and status.",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *invoicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.InvoicesDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := parseTimeWindow(state.StartTime, state.EndTime, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading QuickNode invoices")
+
+	invoicesResp, err := d.client.API.InvoicesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Invoices",
+			err.Error(),
+		)
+		return
+	}
+	if invoicesResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Unable to Read QuickNode Invoices",
+			client.NewAPIError(invoicesResp.HTTPResponse, invoicesResp.Body),
+		))
+		return
+	}
+
+	// The API has no filters, so the invoices are filtered here.
+	var totalDue, totalPaid int64
+	state.Invoices = []models.InvoiceModel{}
+	if data := invoicesResp.JSON200.Data; data != nil && data.Invoices != nil {
+		for _, invoice := range *data.Invoices {
+			if !state.Status.IsNull() && (invoice.Status == nil || *invoice.Status != state.Status.ValueString()) {
+				continue
+			}
+			if window.isBounded() && (invoice.Created == nil || !window.contains(time.Unix(int64(*invoice.Created), 0))) {
+				continue
+			}
+
+			invoiceState := models.InvoiceModel{
+				ID:            types.StringPointerValue(invoice.Id),
+				Status:        types.StringPointerValue(invoice.Status),
+				BillingReason: types.StringPointerValue(invoice.BillingReason),
+				AmountDue:     typeutil.IntPointerToInt64(invoice.AmountDue),
+				AmountPaid:    typeutil.IntPointerToInt64(invoice.AmountPaid),
+				Subtotal:      typeutil.IntPointerToInt64(invoice.Subtotal),
+				Created:       unixToString(invoice.Created),
+				PeriodStart:   unixToString(invoice.PeriodStart),
+				PeriodEnd:     unixToString(invoice.PeriodEnd),
+				Lines:         []models.InvoiceLineModel{},
+			}
+			if invoice.Lines != nil {
+				for _, line := range *invoice.Lines {
+					invoiceState.Lines = append(invoiceState.Lines, models.InvoiceLineModel{
+						Description: types.StringPointerValue(line.Description),
+						Amount:      typeutil.IntPointerToInt64(line.Amount),
+					})
+				}
+			}
+			if invoice.AmountDue != nil {
+				totalDue += int64(*invoice.AmountDue)
+			}
+			if invoice.AmountPaid != nil {
+				totalPaid += int64(*invoice.AmountPaid)
+			}
+
+			state.Invoices = append(state.Invoices, invoiceState)
+		}
+	}
+	state.TotalAmountDue = types.Int64Value(totalDue)
+	state.TotalAmountPaid = types.Int64Value(totalPaid)
+
+	tflog.Debug(ctx, "Received QuickNode invoices", map[string]interface{}{
+		"count": len(state.Invoices),
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *invoicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,39 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInvoicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoicesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quicknode_invoices.all", "invoices.#"),
+					resource.TestCheckResourceAttrSet("data.quicknode_invoices.all", "total_amount_due"),
+					resource.TestCheckResourceAttrSet("data.quicknode_invoices.all", "total_amount_paid"),
+					resource.TestCheckResourceAttrSet("data.quicknode_invoices.paid_last_year", "invoices.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInvoicesDataSourceConfig = `
+data "quicknode_invoices" "all" {}
+
+data "quicknode_invoices" "paid_last_year" {
+  start_time = "365d"
+  status     = "paid"
+}
+`
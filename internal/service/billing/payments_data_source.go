@@ -0,0 +1,196 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &paymentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &paymentsDataSource{}
+)
+
+// paymentTimeLayouts are the layouts the created_at timestamp of a payment is parsed with.
+var paymentTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+}
+
+// NewPaymentsDataSource is a helper function to simplify the provider implementation.
+func NewPaymentsDataSource() datasource.DataSource {
+	return &paymentsDataSource{}
+}
+
+// paymentsDataSource is the data source implementation.
+type paymentsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *paymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payments"
+}
+
+// Schema defines the schema for the data source.
+func (d *paymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := timeWindowAttributes("payments")
+	attributes["status"] = schema.StringAttribute{
+		Description: "Only return payments with this status, such as `succeeded` or `failed`.",
+		Optional:    true,
+	}
+	attributes["payments"] = schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"amount": schema.StringAttribute{
+					Description: "The amount of the payment.",
+					Computed:    true,
+				},
+				"currency": schema.StringAttribute{
+					Description: "The currency of the payment.",
+					Computed:    true,
+				},
+				"status": schema.StringAttribute{
+					Description: "The status of the payment.",
+					Computed:    true,
+				},
+				"card_last_4": schema.StringAttribute{
+					Description: "The last 4 digits of the card the payment was made with.",
+					Computed:    true,
+				},
+				"marketplace_amount": schema.Int64Attribute{
+					Description: "The part of the payment spent on marketplace add-ons.",
+					Computed:    true,
+				},
+				"created_at": schema.StringAttribute{
+					Description: "When the payment was made.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the payments of the account, optionally filtered by creation time and status.",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *paymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.PaymentsDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, diags := parseTimeWindow(state.StartTime, state.EndTime, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading QuickNode payments")
+
+	paymentsResp, err := d.client.API.PaymentsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Payments",
+			err.Error(),
+		)
+		return
+	}
+	if paymentsResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Unable to Read QuickNode Payments",
+			client.NewAPIError(paymentsResp.HTTPResponse, paymentsResp.Body),
+		))
+		return
+	}
+
+	// The API has no filters, so the payments are filtered here.
+	state.Payments = []models.PaymentModel{}
+	if data := paymentsResp.JSON200.Data; data != nil && data.Payments != nil {
+		for _, payment := range *data.Payments {
+			if !state.Status.IsNull() && (payment.Status == nil || *payment.Status != state.Status.ValueString()) {
+				continue
+			}
+			if window.isBounded() {
+				createdAt, ok := parsePaymentTime(payment.CreatedAt)
+				if !ok || !window.contains(createdAt) {
+					continue
+				}
+			}
+
+			state.Payments = append(state.Payments, models.PaymentModel{
+				Amount:            types.StringPointerValue(payment.Amount),
+				Currency:          types.StringPointerValue(payment.Currency),
+				Status:            types.StringPointerValue(payment.Status),
+				CardLast4:         types.StringPointerValue(payment.CardLast4),
+				MarketplaceAmount: typeutil.IntPointerToInt64(payment.MarketplaceAmount),
+				CreatedAt:         types.StringPointerValue(payment.CreatedAt),
+			})
+		}
+	}
+
+	tflog.Debug(ctx, "Received QuickNode payments", map[string]interface{}{
+		"count": len(state.Payments),
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *paymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// parsePaymentTime parses the created_at timestamp of a payment.
+func parsePaymentTime(createdAt *string) (time.Time, bool) {
+	if createdAt == nil {
+		return time.Time{}, false
+	}
+	for _, layout := range paymentTimeLayouts {
+		if t, err := time.Parse(layout, *createdAt); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,37 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package billing_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPaymentsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPaymentsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quicknode_payments.all", "payments.#"),
+					resource.TestCheckResourceAttrSet("data.quicknode_payments.last_quarter", "payments.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPaymentsDataSourceConfig = `
+data "quicknode_payments" "all" {}
+
+data "quicknode_payments" "last_quarter" {
+  start_time = "90d"
+  end_time   = "1h"
+}
+`
@@ -0,0 +1,91 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/timeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// timeWindow is an optionally bounded time range used to filter billing records.
+type timeWindow struct {
+	start *time.Time
+	end   *time.Time
+}
+
+// timeWindowAttributes returns the schema attributes used to filter billing records by time.
+func timeWindowAttributes(records string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"start_time": schema.StringAttribute{
+			Description: fmt.Sprintf("Only return %s created at or after this time, either as an RFC3339 timestamp or as a duration before now such as `720h` or `90d`.", records),
+			Optional:    true,
+		},
+		"end_time": schema.StringAttribute{
+			Description: fmt.Sprintf("Only return %s created before this time, either as an RFC3339 timestamp or as a duration before now such as `24h`.", records),
+			Optional:    true,
+		},
+	}
+}
+
+// parseTimeWindow resolves the start_time and end_time arguments relative to now.
+func parseTimeWindow(startTime, endTime types.String, now time.Time) (timeWindow, diag.Diagnostics) {
+	var w timeWindow
+	var diags diag.Diagnostics
+
+	if !startTime.IsNull() {
+		start, err := timeutil.ParseTime(startTime.ValueString(), now)
+		if err != nil {
+			diags.AddAttributeError(path.Root("start_time"), "Invalid Start Time", err.Error())
+		} else {
+			w.start = &start
+		}
+	}
+	if !endTime.IsNull() {
+		end, err := timeutil.ParseTime(endTime.ValueString(), now)
+		if err != nil {
+			diags.AddAttributeError(path.Root("end_time"), "Invalid End Time", err.Error())
+		} else {
+			w.end = &end
+		}
+	}
+	if w.start != nil && w.end != nil && !w.start.Before(*w.end) {
+		diags.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid Time Window",
+			fmt.Sprintf("start_time (%s) must be before end_time (%s).", w.start.Format(time.RFC3339), w.end.Format(time.RFC3339)),
+		)
+	}
+	return w, diags
+}
+
+// isBounded reports whether the window has a start or an end.
+func (w timeWindow) isBounded() bool {
+	return w.start != nil || w.end != nil
+}
+
+// contains reports whether t falls within the window.
+func (w timeWindow) contains(t time.Time) bool {
+	if w.start != nil && t.Before(*w.start) {
+		return false
+	}
+	if w.end != nil && !t.Before(*w.end) {
+		return false
+	}
+	return true
+}
+
+// unixToString converts an optional unix timestamp to an RFC3339 Terraform String value.
+func unixToString(v *int) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(int64(*v), 0).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTimeWindow_Contains(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	w, diags := parseTimeWindow(types.StringValue("30d"), types.StringValue("2024-05-31T00:00:00Z"), now)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !w.isBounded() {
+		t.Fatal("expected a bounded window")
+	}
+
+	tests := map[time.Time]bool{
+		time.Date(2024, 5, 1, 23, 59, 59, 0, time.UTC): false,
+		time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC):    true,
+		time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC):   true,
+		time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC):   false,
+	}
+	for ts, want := range tests {
+		if got := w.contains(ts); got != want {
+			t.Errorf("%s: expected %t, got %t", ts, want, got)
+		}
+	}
+}
+
+func TestParseTimeWindow_Unbounded(t *testing.T) {
+	w, diags := parseTimeWindow(types.StringNull(), types.StringNull(), time.Now())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if w.isBounded() {
+		t.Fatal("expected an unbounded window")
+	}
+	if !w.contains(time.Unix(0, 0)) {
+		t.Error("expected an unbounded window to contain any time")
+	}
+}
+
+func TestParseTimeWindow_Invalid(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, diags := parseTimeWindow(types.StringValue("last month"), types.StringNull(), now); !diags.HasError() {
+		t.Error("expected an error for an invalid start_time")
+	}
+	if _, diags := parseTimeWindow(types.StringValue("1d"), types.StringValue("7d"), now); !diags.HasError() {
+		t.Error("expected an error when start_time is after end_time")
+	}
+}
+
+func TestParsePaymentTime(t *testing.T) {
+	for _, value := range []string{"2024-05-02T10:00:00Z", "2024-05-02T10:00:00.000Z", "2024-05-02 10:00:00 UTC"} {
+		got, ok := parsePaymentTime(&value)
+		if !ok {
+			t.Errorf("%q: expected to parse", value)
+			continue
+		}
+		if want := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("%q: expected %s, got %s", value, want, got)
+		}
+	}
+
+	invalid := "yesterday"
+	if _, ok := parsePaymentTime(&invalid); ok {
+		t.Error("expected an invalid timestamp not to parse")
+	}
+	if _, ok := parsePaymentTime(nil); ok {
+		t.Error("expected a nil timestamp not to parse")
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -34,19 +37,44 @@ type chainsDataSource struct {
 
 // chainsDataSourceModel maps the data source schema data.
 type chainsDataSourceModel struct {
-	Chains []chainsModel `tfsdk:"chains"`
+	Chain         types.String  `tfsdk:"chain"`
+	Network       types.String  `tfsdk:"network"`
+	ChainID       types.Int64   `tfsdk:"chain_id"`
+	SelectOnly    types.Bool    `tfsdk:"select_only"`
+	SingleNetwork types.Bool    `tfsdk:"single_network"`
+	Match         *matchModel   `tfsdk:"match"`
+	Chains        []chainsModel `tfsdk:"chains"`
 }
 
 // chainsModel maps chains schema data.
 type chainsModel struct {
-	Slug     types.String    `tfsdk:"slug"`
-	Networks []networksModel `tfsdk:"networks"`
+	Slug          types.String    `tfsdk:"slug"`
+	IsSelectChain types.Bool      `tfsdk:"is_select_chain"`
+	Networks      []networksModel `tfsdk:"networks"`
 }
 
 // networksModel maps networks schema data.
 type networksModel struct {
-	Slug types.String `tfsdk:"slug"`
-	Name types.String `tfsdk:"name"`
+	Slug    types.String `tfsdk:"slug"`
+	Name    types.String `tfsdk:"name"`
+	ChainID types.Int64  `tfsdk:"chain_id"`
+}
+
+// matchModel maps the network found in single network mode.
+type matchModel struct {
+	Chain         types.String `tfsdk:"chain"`
+	Network       types.String `tfsdk:"network"`
+	Name          types.String `tfsdk:"name"`
+	ChainID       types.Int64  `tfsdk:"chain_id"`
+	IsSelectChain types.Bool   `tfsdk:"is_select_chain"`
+}
+
+// chainsFilter holds the filter arguments of the data source.
+type chainsFilter struct {
+	chain      *string
+	network    *string
+	chainID    *int
+	selectOnly bool
 }
 
 // Metadata returns the data source type name.
@@ -57,8 +85,55 @@ func (d *chainsDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 // Schema defines the schema for the data source.
 func (d *chainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches the list of chains from the QuickNode API.",
+		Description: "Fetches the list of chains from the QuickNode API, optionally filtered by chain, network, EVM chain ID or select chains.",
 		Attributes: map[string]schema.Attribute{
+			"chain": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the chain with this slug, such as `eth`.",
+			},
+			"network": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return networks with this slug, such as `mainnet`.",
+			},
+			"chain_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only return networks with this EVM chain ID, such as `1` for Ethereum mainnet.",
+			},
+			"select_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return select chains. Defaults to `false`.",
+			},
+			"single_network": schema.BoolAttribute{
+				Optional: true,
+				Description: "Require the filters to match exactly one network and set `match` to it. " +
+					"Reading the data source fails when no network or more than one network matches. Defaults to `false`.",
+			},
+			"match": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The network matched in `single_network` mode.",
+				Attributes: map[string]schema.Attribute{
+					"chain": schema.StringAttribute{
+						Computed:    true,
+						Description: "The slug of the chain.",
+					},
+					"network": schema.StringAttribute{
+						Computed:    true,
+						Description: "The slug of the network.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the network.",
+					},
+					"chain_id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The EVM chain ID of the network. Not set for non-EVM networks.",
+					},
+					"is_select_chain": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the chain is a select chain.",
+					},
+				},
+			},
 			"chains": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -67,6 +142,10 @@ func (d *chainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Computed:    true,
 							Description: "The slug of the chain.",
 						},
+						"is_select_chain": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the chain is a select chain.",
+						},
 						"networks": schema.ListNestedAttribute{
 							Computed:    true,
 							Description: "The list of networks for the chain.",
@@ -80,6 +159,10 @@ func (d *chainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 										Computed:    true,
 										Description: "The name of the network.",
 									},
+									"chain_id": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The EVM chain ID of the network. Not set for non-EVM networks.",
+									},
 								},
 							},
 						},
@@ -94,6 +177,23 @@ func (d *chainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 func (d *chainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state chainsDataSourceModel
 
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := chainsFilter{
+		chain:      state.Chain.ValueStringPointer(),
+		network:    state.Network.ValueStringPointer(),
+		selectOnly: state.SelectOnly.ValueBool(),
+	}
+	if !state.ChainID.IsNull() {
+		chainID := int(state.ChainID.ValueInt64())
+		filter.chainID = &chainID
+	}
+
 	tflog.Debug(ctx, "Reading QuickNode chains")
 
 	chainsResp, err := d.client.API.ChainsWithResponse(ctx)
@@ -106,29 +206,34 @@ func (d *chainsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	if chainsResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Unable to Read QuickNode Chains",
-			fmt.Sprintf("API returned status %d: %s", chainsResp.StatusCode(), string(chainsResp.Body)),
-		)
+			client.NewAPIError(chainsResp.HTTPResponse, chainsResp.Body),
+		))
 		return
 	}
 
-	chains := chainsResp.JSON200.Data
+	chains := filterChains(chainsResp.JSON200.Data, filter)
 
 	tflog.Debug(ctx, "Received QuickNode chains", map[string]interface{}{
-		"count": len(chains),
+		"count":    len(chainsResp.JSON200.Data),
+		"filtered": len(chains),
 	})
 
+	state.Chains = []chainsModel{}
 	for _, chain := range chains {
 		chainState := chainsModel{
-			Slug: types.StringPointerValue(chain.Slug),
+			Slug:          types.StringPointerValue(chain.Slug),
+			IsSelectChain: types.BoolValue(chain.IsSelectChain != nil && *chain.IsSelectChain),
+			Networks:      []networksModel{},
 		}
 
 		if chain.Networks != nil {
 			for _, network := range *chain.Networks {
 				chainState.Networks = append(chainState.Networks, networksModel{
-					Slug: types.StringPointerValue(network.Slug),
-					Name: types.StringPointerValue(network.Name),
+					Slug:    types.StringPointerValue(network.Slug),
+					Name:    types.StringPointerValue(network.Name),
+					ChainID: typeutil.IntPointerToInt64(network.ChainId),
 				})
 			}
 		}
@@ -136,7 +241,40 @@ func (d *chainsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		state.Chains = append(state.Chains, chainState)
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	state.Match = nil
+	if state.SingleNetwork.ValueBool() {
+		var matches []string
+		for _, chain := range state.Chains {
+			for _, network := range chain.Networks {
+				matches = append(matches, chain.Slug.ValueString()+"/"+network.Slug.ValueString())
+				state.Match = &matchModel{
+					Chain:         chain.Slug,
+					Network:       network.Slug,
+					Name:          network.Name,
+					ChainID:       network.ChainID,
+					IsSelectChain: chain.IsSelectChain,
+				}
+			}
+		}
+
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"No Matching QuickNode Network",
+				"No QuickNode network matches the given chain, network, chain_id and select_only filters.",
+			)
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Matching QuickNode Networks",
+				fmt.Sprintf("Expected the filters to match a single network, but %d networks match: %s. Narrow down the filters.",
+					len(matches), strings.Join(matches, ", ")),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -161,3 +299,39 @@ func (d *chainsDataSource) Configure(_ context.Context, req datasource.Configure
 
 	d.client = client
 }
+
+// filterChains returns the chains and networks that match the filter. Chains
+// without any matching network are left out when filtering on networks.
+func filterChains(chains []api.Chain, filter chainsFilter) []api.Chain {
+	filtered := []api.Chain{}
+	for _, chain := range chains {
+		if filter.chain != nil && (chain.Slug == nil || *chain.Slug != *filter.chain) {
+			continue
+		}
+		if filter.selectOnly && (chain.IsSelectChain == nil || !*chain.IsSelectChain) {
+			continue
+		}
+
+		if filter.network != nil || filter.chainID != nil {
+			networks := []api.Network{}
+			if chain.Networks != nil {
+				for _, network := range *chain.Networks {
+					if filter.network != nil && (network.Slug == nil || *network.Slug != *filter.network) {
+						continue
+					}
+					if filter.chainID != nil && (network.ChainId == nil || *network.ChainId != *filter.chainID) {
+						continue
+					}
+					networks = append(networks, network)
+				}
+			}
+			if len(networks) == 0 {
+				continue
+			}
+			chain.Networks = &networks
+		}
+
+		filtered = append(filtered, chain)
+	}
+	return filtered
+}
@@ -4,6 +4,7 @@
 package chains_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
@@ -22,6 +23,20 @@ func TestAccChainsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.quicknode_chains.test", "chains.#"),
 				),
 			},
+			{
+				Config: testAccChainsDataSourceFilteredConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_chains.by_chain_id", "chains.#", "1"),
+					resource.TestCheckResourceAttr("data.quicknode_chains.by_chain_id", "match.chain", "eth"),
+					resource.TestCheckResourceAttr("data.quicknode_chains.by_chain_id", "match.network", "mainnet"),
+					resource.TestCheckResourceAttr("data.quicknode_chains.by_chain_id", "match.chain_id", "1"),
+					resource.TestCheckResourceAttr("data.quicknode_chains.eth", "chains.0.slug", "eth"),
+				),
+			},
+			{
+				Config:      testAccChainsDataSourceNoMatchConfig,
+				ExpectError: regexp.MustCompile("No Matching QuickNode Network"),
+			},
 		},
 	})
 }
@@ -29,3 +44,22 @@ func TestAccChainsDataSource(t *testing.T) {
 const testAccChainsDataSourceConfig = `
 data "quicknode_chains" "test" {}
 `
+
+const testAccChainsDataSourceFilteredConfig = `
+data "quicknode_chains" "by_chain_id" {
+  chain_id       = 1
+  single_network = true
+}
+
+data "quicknode_chains" "eth" {
+  chain = "eth"
+}
+`
+
+const testAccChainsDataSourceNoMatchConfig = `
+data "quicknode_chains" "missing" {
+  chain          = "eth"
+  chain_id       = 999999999
+  single_network = true
+}
+`
@@ -0,0 +1,81 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+func testChains() []api.Chain {
+	ptr := func(s string) *string { return &s }
+	id := func(i int) *int { return &i }
+	yes, no := true, false
+
+	return []api.Chain{
+		{Slug: ptr("eth"), IsSelectChain: &no, Networks: &[]api.Network{
+			{Slug: ptr("mainnet"), Name: ptr("Ethereum Mainnet"), ChainId: id(1)},
+			{Slug: ptr("sepolia"), Name: ptr("Ethereum Sepolia"), ChainId: id(11155111)},
+		}},
+		{Slug: ptr("optimism"), IsSelectChain: &no, Networks: &[]api.Network{
+			{Slug: ptr("optimism"), Name: ptr("Optimism Mainnet"), ChainId: id(10)},
+			{Slug: ptr("optimism-sepolia"), Name: ptr("Optimism Sepolia"), ChainId: id(11155420)},
+		}},
+		{Slug: ptr("solana"), IsSelectChain: &yes, Networks: &[]api.Network{
+			{Slug: ptr("mainnet"), Name: ptr("Solana Mainnet")},
+		}},
+	}
+}
+
+func TestFilterChains_NoFilter(t *testing.T) {
+	if got := filterChains(testChains(), chainsFilter{}); len(got) != 3 {
+		t.Errorf("expected 3 chains, got %d", len(got))
+	}
+}
+
+func TestFilterChains_Chain(t *testing.T) {
+	chain := "optimism"
+	got := filterChains(testChains(), chainsFilter{chain: &chain})
+
+	if len(got) != 1 || *got[0].Slug != "optimism" || len(*got[0].Networks) != 2 {
+		t.Errorf("expected the optimism chain with 2 networks, got %+v", got)
+	}
+}
+
+func TestFilterChains_ChainID(t *testing.T) {
+	chainID := 11155420
+	got := filterChains(testChains(), chainsFilter{chainID: &chainID})
+
+	if len(got) != 1 || *got[0].Slug != "optimism" {
+		t.Fatalf("expected only the optimism chain, got %+v", got)
+	}
+	if networks := *got[0].Networks; len(networks) != 1 || *networks[0].Slug != "optimism-sepolia" {
+		t.Errorf("expected only the optimism-sepolia network, got %+v", networks)
+	}
+}
+
+func TestFilterChains_NetworkAcrossChains(t *testing.T) {
+	network := "mainnet"
+	got := filterChains(testChains(), chainsFilter{network: &network})
+
+	if len(got) != 2 || *got[0].Slug != "eth" || *got[1].Slug != "solana" {
+		t.Errorf("expected the eth and solana chains, got %+v", got)
+	}
+}
+
+func TestFilterChains_SelectOnly(t *testing.T) {
+	got := filterChains(testChains(), chainsFilter{selectOnly: true})
+
+	if len(got) != 1 || *got[0].Slug != "solana" {
+		t.Errorf("expected only the solana chain, got %+v", got)
+	}
+}
+
+func TestFilterChains_NoMatch(t *testing.T) {
+	chain, chainID := "solana", 1
+	if got := filterChains(testChains(), chainsFilter{chain: &chain, chainID: &chainID}); len(got) != 0 {
+		t.Errorf("expected no chains, got %+v", got)
+	}
+}
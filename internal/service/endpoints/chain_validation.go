@@ -0,0 +1,150 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	// maxSuggestions is the number of closest slugs suggested for a typo.
+	maxSuggestions = 3
+	// maxListedNetworks is the number of networks of a chain listed when no slug is close to a typo.
+	maxListedNetworks = 10
+)
+
+// validateChainNetwork checks the chain and network slugs against the chains
+// catalog and reports an attribute error with the closest valid slugs.
+func validateChainNetwork(chains []api.Chain, chain, network string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var chainSlugs []string
+	var match *api.Chain
+	for i, c := range chains {
+		if c.Slug == nil {
+			continue
+		}
+		chainSlugs = append(chainSlugs, *c.Slug)
+		if *c.Slug == chain {
+			match = &chains[i]
+		}
+	}
+	if match == nil {
+		diags.AddAttributeError(
+			path.Root("chain"),
+			"Unsupported Chain",
+			fmt.Sprintf("Chain %q is not supported by QuickNode. %s", chain,
+				suggest(chain, chainSlugs, "Use the quicknode_chains data source to list the supported chains.")),
+		)
+		return diags
+	}
+
+	var networkSlugs []string
+	if match.Networks != nil {
+		for _, n := range *match.Networks {
+			if n.Slug == nil {
+				continue
+			}
+			if *n.Slug == network {
+				return diags
+			}
+			networkSlugs = append(networkSlugs, *n.Slug)
+		}
+	}
+
+	fallback := "The chain has no networks."
+	if len(networkSlugs) > 0 {
+		listed := networkSlugs[:min(len(networkSlugs), maxListedNetworks)]
+		fallback = "Supported networks: " + strings.Join(listed, ", ")
+		if len(networkSlugs) > len(listed) {
+			fallback += fmt.Sprintf(" and %d more", len(networkSlugs)-len(listed))
+		}
+		fallback += "."
+	}
+	diags.AddAttributeError(
+		path.Root("network"),
+		"Unsupported Network",
+		fmt.Sprintf("Network %q is not supported on chain %q. %s", network, chain, suggest(network, networkSlugs, fallback)),
+	)
+	return diags
+}
+
+// suggest returns a "Did you mean" sentence with the closest candidates, or
+// the fallback when no candidate is close enough.
+func suggest(value string, candidates []string, fallback string) string {
+	closest := closestMatches(value, candidates)
+	if len(closest) == 0 {
+		return fallback
+	}
+	quoted := make([]string, len(closest))
+	for i, c := range closest {
+		quoted[i] = fmt.Sprintf("%q", c)
+	}
+	return "Did you mean " + strings.Join(quoted, " or ") + "?"
+}
+
+// closestMatches returns up to maxSuggestions candidates close to value,
+// closest first. Candidates that are a prefix of the value or start with it
+// count as close, so "ethereum" suggests "eth".
+func closestMatches(value string, candidates []string) []string {
+	value = strings.ToLower(value)
+	threshold := max(2, len(value)/3)
+
+	type scored struct {
+		slug     string
+		distance int
+	}
+	var matches []scored
+	for _, c := range candidates {
+		lower := strings.ToLower(c)
+		distance := levenshtein(value, lower)
+		if strings.HasPrefix(lower, value) || strings.HasPrefix(value, lower) {
+			distance = min(distance, 1)
+		}
+		if distance <= threshold {
+			matches = append(matches, scored{c, distance})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].slug < matches[j].slug
+	})
+
+	var closest []string
+	for _, m := range matches[:min(len(matches), maxSuggestions)] {
+		closest = append(closest, m.slug)
+	}
+	return closest
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
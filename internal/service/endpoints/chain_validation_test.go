@@ -0,0 +1,101 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func testCatalog() []api.Chain {
+	ptr := func(s string) *string { return &s }
+	return []api.Chain{
+		{Slug: ptr("eth"), Networks: &[]api.Network{{Slug: ptr("mainnet")}, {Slug: ptr("sepolia")}, {Slug: ptr("holesky")}}},
+		{Slug: ptr("optimism"), Networks: &[]api.Network{{Slug: ptr("optimism")}, {Slug: ptr("optimism-sepolia")}}},
+		{Slug: ptr("solana"), Networks: &[]api.Network{{Slug: ptr("mainnet")}, {Slug: ptr("devnet")}}},
+	}
+}
+
+// requireAttributeError returns the detail of the single error of diags on the given attribute.
+func requireAttributeError(t *testing.T, diags diag.Diagnostics, attribute string) string {
+	t.Helper()
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %v", diags)
+	}
+	withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root(attribute)) {
+		t.Fatalf("expected an error on %s, got %v", attribute, diags)
+	}
+	return withPath.Detail()
+}
+
+func TestValidateChainNetwork_Valid(t *testing.T) {
+	if diags := validateChainNetwork(testCatalog(), "optimism", "optimism-sepolia"); diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+}
+
+func TestValidateChainNetwork_ChainTypo(t *testing.T) {
+	detail := requireAttributeError(t, validateChainNetwork(testCatalog(), "optimsm", "optimism"), "chain")
+
+	if !strings.Contains(detail, `Did you mean "optimism"?`) {
+		t.Errorf("expected a suggestion, got %q", detail)
+	}
+}
+
+func TestValidateChainNetwork_ChainPrefix(t *testing.T) {
+	detail := requireAttributeError(t, validateChainNetwork(testCatalog(), "ethereum", "mainnet"), "chain")
+
+	if !strings.Contains(detail, `Did you mean "eth"?`) {
+		t.Errorf("expected a suggestion, got %q", detail)
+	}
+}
+
+func TestValidateChainNetwork_UnknownChain(t *testing.T) {
+	detail := requireAttributeError(t, validateChainNetwork(testCatalog(), "dogecoin", "mainnet"), "chain")
+
+	if strings.Contains(detail, "Did you mean") || !strings.Contains(detail, "quicknode_chains") {
+		t.Errorf("expected no suggestion, got %q", detail)
+	}
+}
+
+func TestValidateChainNetwork_NetworkTypo(t *testing.T) {
+	detail := requireAttributeError(t, validateChainNetwork(testCatalog(), "eth", "mainet"), "network")
+
+	if !strings.Contains(detail, `Did you mean "mainnet"?`) {
+		t.Errorf("expected a suggestion, got %q", detail)
+	}
+}
+
+func TestValidateChainNetwork_NetworkOfOtherChain(t *testing.T) {
+	detail := requireAttributeError(t, validateChainNetwork(testCatalog(), "solana", "sepolia"), "network")
+
+	if !strings.Contains(detail, "Supported networks: mainnet, devnet.") {
+		t.Errorf("expected the supported networks, got %q", detail)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"eth", "", 3},
+		{"mainet", "mainnet", 1},
+		{"sepolia", "spolia", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q): expected %d, got %d", tt.a, tt.b, tt.want, got)
+		}
+	}
+}
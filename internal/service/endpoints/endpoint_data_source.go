@@ -99,6 +99,10 @@ func (d *endpointDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 					},
 				},
 			},
+			"ip_custom_header": schema.StringAttribute{
+				Description: "The name of the request header that carries the client IP for IP whitelisting.",
+				Computed:    true,
+			},
 			"status": schema.StringAttribute{
 				Description: "The status of the endpoint.",
 				Computed:    true,
@@ -132,10 +136,10 @@ func (d *endpointDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 
@@ -0,0 +1,53 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+// newShowEndpointResponse returns a ShowEndpoint response with the status
+// code and, for a 200, an endpoint with the status.
+func newShowEndpointResponse(statusCode int, status *string) *api.ShowEndpointResponse {
+	resp := &api.ShowEndpointResponse{HTTPResponse: &http.Response{StatusCode: statusCode}}
+	if statusCode == http.StatusOK {
+		resp.JSON200 = &struct {
+			Data  *api.SingleEndpoint `json:"data,omitempty"`
+			Error *string             `json:"error"`
+		}{Data: &api.SingleEndpoint{Id: "ep-1", Status: status}}
+	}
+	return resp
+}
+
+func TestEndpointGone(t *testing.T) {
+	active := "active"
+	paused := "paused"
+	archived := endpointStatusArchived
+
+	tests := []struct {
+		name       string
+		statusCode int
+		status     *string
+		want       bool
+	}{
+		{name: "active", statusCode: http.StatusOK, status: &active, want: false},
+		{name: "paused", statusCode: http.StatusOK, status: &paused, want: false},
+		{name: "no status", statusCode: http.StatusOK, want: false},
+		{name: "archived", statusCode: http.StatusOK, status: &archived, want: true},
+		{name: "not found", statusCode: http.StatusNotFound, want: true},
+		{name: "server error", statusCode: http.StatusInternalServerError, want: false},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := endpointGone(newShowEndpointResponse(tt.statusCode, tt.status)); got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
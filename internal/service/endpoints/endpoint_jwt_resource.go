@@ -0,0 +1,421 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &endpointJWTResource{}
+	_ resource.ResourceWithConfigure   = &endpointJWTResource{}
+	_ resource.ResourceWithImportState = &endpointJWTResource{}
+)
+
+// requiresReplaceUnlessImportedDescription describes the plan modifiers of the
+// public key attributes. Both are null in the state of an imported JWT, which
+// adopts the configured values instead of being replaced.
+const requiresReplaceUnlessImportedDescription = "Changing the value requires replacing the JWT, unless the JWT was imported."
+
+// NewEndpointJWTResource is a helper function to simplify the provider implementation.
+func NewEndpointJWTResource() resource.Resource {
+	return &endpointJWTResource{}
+}
+
+// endpointJWTResource is the resource implementation.
+type endpointJWTResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *endpointJWTResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_jwt"
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointJWTResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a new JWT public key on an endpoint in the QuickNode API. " +
+			"An imported JWT doesn't read its public key back from the API, as the configuration may pass it through `public_key_wo`: " +
+			"the first apply after the import checks the configured `public_key` or `public_key_wo` against the key registered in the API, " +
+			"then stores `public_key` or `public_key_wo_version` without replacing the JWT.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the created endpoint JWT.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A descriptive name for the JWT public key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The PEM-encoded public key used to verify JWTs sent to the endpoint. Exactly one of `public_key` and `public_key_wo` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						requiresReplaceUnlessImportedDescription, requiresReplaceUnlessImportedDescription,
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("public_key_wo")),
+				},
+			},
+			"public_key_wo": schema.StringAttribute{
+				Description: "The PEM-encoded public key used to verify JWTs sent to the endpoint, as a write-only argument that is never stored in the plan or state. " +
+					"Requires Terraform 1.11 or later, and `public_key_wo_version`.",
+				Optional:  true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("public_key_wo_version")),
+				},
+			},
+			"public_key_wo_version": schema.Int64Attribute{
+				Description: "The version of `public_key_wo`. As Terraform can't detect changes of write-only arguments, " +
+					"change this value to replace the JWT with the current `public_key_wo`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						requiresReplaceUnlessImportedDescription, requiresReplaceUnlessImportedDescription,
+					),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("public_key_wo")),
+				},
+			},
+			"kid": schema.StringAttribute{
+				Description: "The key ID (kid) that JWTs signed with the matching private key carry in their header.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to register the JWT public key for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *endpointJWTResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.EndpointJWTResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only arguments are only available in the configuration.
+	var publicKeyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key_wo"), &publicKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	publicKey := plan.PublicKey.ValueString()
+	if !publicKeyWO.IsNull() {
+		publicKey = publicKeyWO.ValueString()
+	}
+	body := api.CreateJwtJSONRequestBody{
+		Name:      &name,
+		PublicKey: &publicKey,
+	}
+	if !plan.Kid.IsNull() && !plan.Kid.IsUnknown() {
+		kid := plan.Kid.ValueString()
+		body.Kid = &kid
+	}
+
+	// Create new JWT.
+	createResp, err := r.client.API.CreateJwtWithResponse(ctx, plan.EndpointID.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint JWT",
+			"Could not create endpoint JWT, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error creating endpoint JWT",
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
+		return
+	}
+
+	// The CreateJwt response doesn't have a typed JSON200 in the spec, so we parse the raw body.
+	var jwtResp struct {
+		Data api.EndpointJwt `json:"data"`
+	}
+	if err := json.Unmarshal(createResp.Body, &jwtResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing endpoint JWT response",
+			"Could not parse response: "+err.Error(),
+		)
+		return
+	}
+	if jwtResp.Data.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint JWT",
+			"API returned an empty response",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(*jwtResp.Data.Id)
+	if plan.Kid.IsUnknown() {
+		kid := ""
+		if jwtResp.Data.Kid != nil {
+			kid = *jwtResp.Data.Kid
+		}
+		plan.Kid = types.StringValue(kid)
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *endpointJWTResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.EndpointJWTResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed endpoint value from QuickNode.
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint",
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
+		return
+	}
+
+	endpoint := showResp.JSON200.Data
+
+	// Find specific JWT in JWTs array.
+	found := false
+	if endpoint.Security.Jwts != nil {
+		for _, jwt := range *endpoint.Security.Jwts {
+			if jwt.Id != nil && *jwt.Id == state.ID.ValueString() {
+				found = true
+				if jwt.Name != nil {
+					state.Name = types.StringValue(*jwt.Name)
+				}
+				// Only refresh a public key already in the state, so a key
+				// passed through public_key_wo never lands in it after import.
+				if jwt.PublicKey != nil && !state.PublicKey.IsNull() {
+					state.PublicKey = types.StringValue(*jwt.PublicKey)
+				}
+				if jwt.Kid != nil {
+					state.Kid = types.StringValue(*jwt.Kid)
+				}
+				break
+			}
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *endpointJWTResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only an imported JWT is updated in-place, to store the configured
+	// public key arguments. Every other change replaces the JWT.
+	var plan models.EndpointJWTResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only arguments are only available in the configuration.
+	var publicKeyWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key_wo"), &publicKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	publicKey := plan.PublicKey.ValueString()
+	if !publicKeyWO.IsNull() {
+		publicKey = publicKeyWO.ValueString()
+	}
+
+	// Check the configured key against the registered one before adopting it.
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, plan.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode endpoint ID "+plan.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint",
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
+		return
+	}
+	var registered *string
+	if jwts := showResp.JSON200.Data.Security.Jwts; jwts != nil {
+		for _, jwt := range *jwts {
+			if jwt.Id != nil && *jwt.Id == plan.ID.ValueString() {
+				registered = jwt.PublicKey
+				break
+			}
+		}
+	}
+	if registered == nil || strings.TrimSpace(*registered) != strings.TrimSpace(publicKey) {
+		resp.Diagnostics.AddError(
+			"Public Key Mismatch",
+			"The configured public key doesn't match the key registered for endpoint JWT ID "+plan.ID.ValueString()+". "+
+				"Configure the registered key, or remove the JWT from the state so that Terraform creates a new one.",
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *endpointJWTResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.EndpointJWTResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing JWT.
+	deleteResp, err := r.client.API.DeleteJwtWithResponse(ctx, state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint JWT",
+			"Could not delete endpoint JWT, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if deleteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Endpoint JWT",
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *endpointJWTResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *endpointJWTResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: endpoint_id/jwt_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
@@ -0,0 +1,169 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccEndpointJWTResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccEndpointJWTResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_jwt.test", "id"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_jwt.test", "endpoint_id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_jwt.test", "name", "tf-acc-test"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_jwt.test", "kid", "tf-acc-test-kid"),
+				),
+			},
+			// ImportState testing. The public key isn't read back on import.
+			{
+				ResourceName:            "quicknode_endpoint_jwt.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_key"},
+				ImportStateIdFunc:       testAccEndpointJWTImportStateIdFunc,
+			},
+		},
+	})
+}
+
+func TestAccEndpointJWTResource_WriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with a write-only public key.
+			{
+				Config: testAccEndpointJWTResourceWriteOnlyConfig(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_jwt.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_jwt.test", "public_key_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint_jwt.test", "public_key"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint_jwt.test", "public_key_wo"),
+				),
+			},
+			// Bumping the version replaces the JWT.
+			{
+				Config: testAccEndpointJWTResourceWriteOnlyConfig(2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("quicknode_endpoint_jwt.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint_jwt.test", "public_key_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint_jwt.test", "public_key"),
+				),
+			},
+			// Importing adopts the configured version without replacing the
+			// JWT, and keeps the public key out of the state.
+			{
+				Config:             testAccEndpointJWTResourceWriteOnlyConfig(2),
+				ResourceName:       "quicknode_endpoint_jwt.test",
+				ImportState:        true,
+				ImportStateKind:    resource.ImportBlockWithID,
+				ImportStateIdFunc:  testAccEndpointJWTImportStateIdFunc,
+				ExpectNonEmptyPlan: true,
+				ImportPlanChecks: resource.ImportPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("quicknode_endpoint_jwt.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("quicknode_endpoint_jwt.test", tfjsonpath.New("public_key"), knownvalue.Null()),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccEndpointJWTImportStateIdFunc(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["quicknode_endpoint_jwt.test"]
+	if !ok {
+		return "", fmt.Errorf("resource not found")
+	}
+	return rs.Primary.Attributes["endpoint_id"] + "/" + rs.Primary.Attributes["id"], nil
+}
+
+const testAccEndpointJWTResourceConfig = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+
+  security_options = {
+    tokens          = false
+    referrers       = false
+    jwts            = true
+    ips             = false
+    domain_masks    = false
+    hsts            = false
+    cors            = true
+    request_filters = false
+  }
+}
+
+resource "quicknode_endpoint_jwt" "test" {
+  name        = "tf-acc-test"
+  kid         = "tf-acc-test-kid"
+  endpoint_id = quicknode_endpoint.test.id
+  public_key  = <<-EOT
+    -----BEGIN PUBLIC KEY-----
+    MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAsnyF6Cj/VsJceXp+EUzA
+    +DLTYLywoOMm+Gd4TBmWfxt5FAx+SFE2TiQSnoB4YcRUJJw+gVkky3IMy730Mpc2
+    1DFpkVIfIyHRiU57R5+YM66GzsIF+IjD69tNGdoCfc0Puwbt4zlbuWid0QsRMIvV
+    H92i35dcuHI1F0UX1emblpm/g/xKTFasqf73CsjQtYkZlbiHALULTRg07ToFIT8U
+    vgplPVSWNz8UD2q4cldClHh+HXgZcpN9RZWuVOhQE06ASibxTU5LwxXILCCykMjp
+    vvSzH8Xj2Ie680Al0TD0YRbk5iwczRGsjVk5FNtxKC+XHmKTWpfRCyI+Sg1E2kvR
+    zQIDAQAB
+    -----END PUBLIC KEY-----
+  EOT
+}
+`
+
+func testAccEndpointJWTResourceWriteOnlyConfig(version int) string {
+	return fmt.Sprintf(`
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+
+  security_options = {
+    jwts = true
+  }
+}
+
+resource "quicknode_endpoint_jwt" "test" {
+  name                  = "tf-acc-test-wo"
+  endpoint_id           = quicknode_endpoint.test.id
+  public_key_wo_version = %d
+  public_key_wo         = <<-EOT
+    -----BEGIN PUBLIC KEY-----
+    MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAsnyF6Cj/VsJceXp+EUzA
+    +DLTYLywoOMm+Gd4TBmWfxt5FAx+SFE2TiQSnoB4YcRUJJw+gVkky3IMy730Mpc2
+    1DFpkVIfIyHRiU57R5+YM66GzsIF+IjD69tNGdoCfc0Puwbt4zlbuWid0QsRMIvV
+    H92i35dcuHI1F0UX1emblpm/g/xKTFasqf73CsjQtYkZlbiHALULTRg07ToFIT8U
+    vgplPVSWNz8UD2q4cldClHh+HXgZcpN9RZWuVOhQE06ASibxTU5LwxXILCCykMjp
+    vvSzH8Xj2Ie680Al0TD0YRbk5iwczRGsjVk5FNtxKC+XHmKTWpfRCyI+Sg1E2kvR
+    zQIDAQAB
+    -----END PUBLIC KEY-----
+  EOT
+}
+`, version)
+}
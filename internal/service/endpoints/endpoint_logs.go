@@ -0,0 +1,176 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxLogsPageSize is the number of log entries requested per page.
+const maxLogsPageSize = 100
+
+// endpointLogResponse is a log entry as returned by the GetEndpointLogs API.
+type endpointLogResponse struct {
+	Timestamp  *string             `json:"timestamp"`
+	RequestID  *string             `json:"request_id"`
+	Method     *string             `json:"method"`
+	Network    *string             `json:"network"`
+	HTTPMethod *string             `json:"http_method"`
+	URL        *string             `json:"url"`
+	Status     *int                `json:"status"`
+	ErrorCode  *int                `json:"error_code"`
+	Details    *logDetailsResponse `json:"details"`
+}
+
+// logDetailsResponse is the request and response body of a log entry.
+type logDetailsResponse struct {
+	Request  *string `json:"request"`
+	Response *string `json:"response"`
+}
+
+// endpointLogsQuery describes the log entries to fetch.
+type endpointLogsQuery struct {
+	endpointID     string
+	from, to       time.Time
+	maxRecords     int
+	includeDetails bool
+}
+
+// fetchEndpointLogs follows the next_at cursor until maxRecords entries are
+// fetched or no pages are left. It returns the entries and the cursor of the
+// next page, which is empty once every entry of the window has been fetched.
+func fetchEndpointLogs(ctx context.Context, c *api.ClientWithResponses, q endpointLogsQuery) ([]endpointLogResponse, string, error) {
+	logs := []endpointLogResponse{}
+	nextAt := ""
+	for len(logs) < q.maxRecords {
+		limit := min(q.maxRecords-len(logs), maxLogsPageSize)
+		params := &api.GetEndpointLogsParams{
+			From:           q.from.UTC().Format(time.RFC3339),
+			To:             q.to.UTC().Format(time.RFC3339),
+			Limit:          &limit,
+			IncludeDetails: &q.includeDetails,
+		}
+		if nextAt != "" {
+			params.NextAt = &nextAt
+		}
+
+		logsResp, err := c.GetEndpointLogsWithResponse(ctx, q.endpointID, params)
+		if err != nil {
+			return nil, "", fmt.Errorf("fetching logs: %w", err)
+		}
+		if logsResp.StatusCode() != http.StatusOK {
+			return nil, "", fmt.Errorf("fetching logs: %w", client.NewAPIError(logsResp.HTTPResponse, logsResp.Body))
+		}
+
+		var page struct {
+			Data   []endpointLogResponse `json:"data"`
+			NextAt *string               `json:"next_at"`
+		}
+		if err := json.Unmarshal(logsResp.Body, &page); err != nil {
+			return nil, "", fmt.Errorf("parsing logs: %w", err)
+		}
+
+		// Never return more than requested, even if the API ignores the limit.
+		// The next_at cursor then points past the dropped entries, so resume
+		// from the first of them instead.
+		if len(page.Data) > limit {
+			cursor, ok := logCursor(page.Data[limit])
+			if !ok {
+				return nil, "", errors.New("fetching logs: the API returned more entries than requested, without a timestamp and request ID to resume from")
+			}
+			logs = append(logs, page.Data[:limit]...)
+			nextAt = cursor
+			continue
+		}
+		logs = append(logs, page.Data...)
+
+		// Stop on an empty page or a cursor that doesn't move, so a
+		// misbehaving API can't keep the loop going forever.
+		if page.NextAt == nil || *page.NextAt == "" || *page.NextAt == nextAt || len(page.Data) == 0 {
+			return logs, "", nil
+		}
+		nextAt = *page.NextAt
+	}
+	return logs, nextAt, nil
+}
+
+// logCursor returns the next_at cursor of a page starting at the log entry.
+// Like the cursors of the API, it is the base64 encoding of the timestamp of
+// the entry in nanoseconds and its request ID, separated by a pipe.
+func logCursor(log endpointLogResponse) (string, bool) {
+	if log.Timestamp == nil || log.RequestID == nil {
+		return "", false
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, *log.Timestamp)
+	if err != nil {
+		return "", false
+	}
+	cursor := strconv.FormatInt(timestamp.UnixNano(), 10) + "|" + *log.RequestID
+	return base64.StdEncoding.EncodeToString([]byte(cursor)), true
+}
+
+// fetchLogDetails fetches the request and response body of a single log entry.
+func fetchLogDetails(ctx context.Context, c *api.ClientWithResponses, endpointID, requestID string) (*logDetailsResponse, error) {
+	detailsResp, err := c.GetLogDetailsWithResponse(ctx, endpointID, &api.GetLogDetailsParams{RequestId: requestID})
+	if err != nil {
+		return nil, fmt.Errorf("fetching details of request %s: %w", requestID, err)
+	}
+	if detailsResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("fetching details of request %s: %w", requestID, client.NewAPIError(detailsResp.HTTPResponse, detailsResp.Body))
+	}
+
+	var details struct {
+		Data *logDetailsResponse `json:"data"`
+	}
+	if err := json.Unmarshal(detailsResp.Body, &details); err != nil {
+		return nil, fmt.Errorf("parsing details of request %s: %w", requestID, err)
+	}
+	return details.Data, nil
+}
+
+// isErrorLog reports whether the request of a log entry failed, either with an
+// HTTP error status or with a JSON-RPC error code.
+func isErrorLog(log endpointLogResponse) bool {
+	return (log.Status != nil && *log.Status >= http.StatusBadRequest) || log.ErrorCode != nil
+}
+
+// flattenEndpointLog converts a log entry to its Terraform model.
+func flattenEndpointLog(log endpointLogResponse) models.EndpointLogModel {
+	m := models.EndpointLogModel{
+		Timestamp:  types.StringPointerValue(log.Timestamp),
+		RequestID:  types.StringPointerValue(log.RequestID),
+		Method:     types.StringPointerValue(log.Method),
+		Network:    types.StringPointerValue(log.Network),
+		HTTPMethod: types.StringPointerValue(log.HTTPMethod),
+		URL:        types.StringPointerValue(log.URL),
+		Status:     types.Int64Null(),
+		ErrorCode:  types.Int64Null(),
+		Request:    types.StringNull(),
+		Response:   types.StringNull(),
+	}
+	if log.Status != nil {
+		m.Status = types.Int64Value(int64(*log.Status))
+	}
+	if log.ErrorCode != nil {
+		m.ErrorCode = types.Int64Value(int64(*log.ErrorCode))
+	}
+	if log.Details != nil {
+		m.Request = types.StringPointerValue(log.Details.Request)
+		m.Response = types.StringPointerValue(log.Details.Response)
+	}
+	return m
+}
@@ -0,0 +1,261 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/timeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultLogsStartTime is the start of the window when start_time is not set.
+	defaultLogsStartTime = "1h"
+	// defaultLogsMaxRecords is the number of log entries fetched when max_records is not set.
+	defaultLogsMaxRecords = 100
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &endpointLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &endpointLogsDataSource{}
+)
+
+// NewEndpointLogsDataSource is a helper function to simplify the provider implementation.
+func NewEndpointLogsDataSource() datasource.DataSource {
+	return &endpointLogsDataSource{}
+}
+
+// endpointLogsDataSource is the data source implementation.
+type endpointLogsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *endpointLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_logs"
+}
+
+// Schema defines the schema for the data source.
+func (d *endpointLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the request logs of an endpoint for a time window, following the pagination cursor up to `max_records` entries.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to fetch the logs for.",
+				Required:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "The start of the window, either as an RFC3339 timestamp or as a duration before now such as `15m` or `24h`. Defaults to `" + defaultLogsStartTime + "`.",
+				Optional:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "The end of the window, either as an RFC3339 timestamp or as a duration before now such as `5m`. Defaults to now.",
+				Optional:    true,
+			},
+			"max_records": schema.Int64Attribute{
+				Description: fmt.Sprintf("The maximum number of log entries to fetch. Defaults to `%d`.", defaultLogsMaxRecords),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"include_details": schema.BoolAttribute{
+				Description: "Whether to include the request and response body of each log entry. Defaults to `false`.",
+				Optional:    true,
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "The number of log entries fetched.",
+				Computed:    true,
+			},
+			"error_count": schema.Int64Attribute{
+				Description: "The number of fetched log entries whose request failed with an HTTP error status or a JSON-RPC error code.",
+				Computed:    true,
+			},
+			"next_at": schema.StringAttribute{
+				Description: "The cursor of the next page when more than `max_records` entries are in the window. Not set when every entry was fetched.",
+				Computed:    true,
+			},
+			"logs": schema.ListNestedAttribute{
+				Description: "The fetched log entries.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Description: "When the request was received.",
+							Computed:    true,
+						},
+						"request_id": schema.StringAttribute{
+							Description: "A unique identifier for the request.",
+							Computed:    true,
+						},
+						"method": schema.StringAttribute{
+							Description: "The RPC method of the request.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "The network the request was sent to.",
+							Computed:    true,
+						},
+						"http_method": schema.StringAttribute{
+							Description: "The HTTP method of the request.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The path the request was sent to.",
+							Computed:    true,
+						},
+						"status": schema.Int64Attribute{
+							Description: "The HTTP status of the response.",
+							Computed:    true,
+						},
+						"error_code": schema.Int64Attribute{
+							Description: "The JSON-RPC error code of the response. Not set when the request succeeded.",
+							Computed:    true,
+						},
+						"request": schema.StringAttribute{
+							Description: "The request body. Only set when `include_details` is `true`.",
+							Computed:    true,
+						},
+						"response": schema.StringAttribute{
+							Description: "The response body. Only set when `include_details` is `true`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *endpointLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.EndpointLogsDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the window.
+	now := time.Now()
+	startTime := defaultLogsStartTime
+	if !state.StartTime.IsNull() {
+		startTime = state.StartTime.ValueString()
+	}
+	start, err := timeutil.ParseTime(startTime, now)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid Start Time", err.Error())
+		return
+	}
+	end := now
+	if !state.EndTime.IsNull() {
+		end, err = timeutil.ParseTime(state.EndTime.ValueString(), now)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid End Time", err.Error())
+			return
+		}
+	}
+	if !start.Before(end) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid Time Window",
+			fmt.Sprintf("start_time (%s) must be before end_time (%s).", start.Format(time.RFC3339), end.Format(time.RFC3339)),
+		)
+		return
+	}
+
+	query := endpointLogsQuery{
+		endpointID:     state.EndpointID.ValueString(),
+		from:           start,
+		to:             end,
+		maxRecords:     defaultLogsMaxRecords,
+		includeDetails: state.IncludeDetails.ValueBool(),
+	}
+	if !state.MaxRecords.IsNull() {
+		query.maxRecords = int(state.MaxRecords.ValueInt64())
+	}
+
+	tflog.Debug(ctx, "Reading QuickNode endpoint logs", map[string]interface{}{
+		"endpoint_id":     query.endpointID,
+		"from":            query.from.Format(time.RFC3339),
+		"to":              query.to.Format(time.RFC3339),
+		"max_records":     query.maxRecords,
+		"include_details": query.includeDetails,
+	})
+
+	logs, nextAt, err := fetchEndpointLogs(ctx, d.client.API, query)
+	if err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Unable to Read QuickNode Endpoint Logs", err))
+		return
+	}
+
+	var errorCount int64
+	state.Logs = []models.EndpointLogModel{}
+	for _, log := range logs {
+		// Fall back to the log details API when the entry came without details.
+		if query.includeDetails && log.Details == nil && log.RequestID != nil {
+			log.Details, err = fetchLogDetails(ctx, d.client.API, query.endpointID, *log.RequestID)
+			if err != nil {
+				resp.Diagnostics.Append(client.ErrorDiagnostic("Unable to Read QuickNode Endpoint Logs", err))
+				return
+			}
+		}
+		if isErrorLog(log) {
+			errorCount++
+		}
+		state.Logs = append(state.Logs, flattenEndpointLog(log))
+	}
+
+	state.TotalCount = types.Int64Value(int64(len(state.Logs)))
+	state.ErrorCount = types.Int64Value(errorCount)
+	state.NextAt = types.StringNull()
+	if nextAt != "" {
+		state.NextAt = types.StringValue(nextAt)
+	}
+
+	tflog.Debug(ctx, "Received QuickNode endpoint logs", map[string]interface{}{
+		"count":  len(state.Logs),
+		"errors": errorCount,
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *endpointLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,49 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointLogsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoint_logs.recent", "total_count"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoint_logs.recent", "error_count"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoint_logs.recent", "logs.#"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoint_logs.detailed", "logs.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEndpointLogsDataSourceConfig = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+}
+
+data "quicknode_endpoint_logs" "recent" {
+  endpoint_id = quicknode_endpoint.test.id
+}
+
+data "quicknode_endpoint_logs" "detailed" {
+  endpoint_id     = quicknode_endpoint.test.id
+  start_time      = "24h"
+  end_time        = "1m"
+  max_records     = 10
+  include_details = true
+}
+`
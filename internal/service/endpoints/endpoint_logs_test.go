@@ -0,0 +1,172 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+// newLogsServer serves total log entries in pages, using the index of the
+// next entry as the next_at cursor.
+func newLogsServer(t *testing.T, total int, requests *[]string) *api.ClientWithResponses {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+
+		start := 0
+		if nextAt := r.URL.Query().Get("next_at"); nextAt != "" {
+			start, _ = strconv.Atoi(nextAt)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := min(start+limit, total)
+
+		entries := []string{}
+		for i := start; i < end; i++ {
+			entries = append(entries, fmt.Sprintf(`{"request_id":"req-%d","status":200}`, i))
+		}
+		nextAt := "null"
+		if end < total {
+			nextAt = fmt.Sprintf(`"%d"`, end)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"next_at":%s}`, strings.Join(entries, ","), nextAt)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return c
+}
+
+func testLogsQuery(maxRecords int) endpointLogsQuery {
+	to := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	return endpointLogsQuery{
+		endpointID: "endpoint-1",
+		from:       to.Add(-time.Hour),
+		to:         to,
+		maxRecords: maxRecords,
+	}
+}
+
+func TestFetchEndpointLogs_FollowsCursor(t *testing.T) {
+	var requests []string
+	c := newLogsServer(t, 250, &requests)
+
+	logs, nextAt, err := fetchEndpointLogs(context.Background(), c, testLogsQuery(1000))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(logs) != 250 {
+		t.Fatalf("expected 250 logs, got %d", len(logs))
+	}
+	if nextAt != "" {
+		t.Errorf("expected no next_at, got %q", nextAt)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+	if !strings.Contains(requests[0], "from=2024-05-02T11%3A00%3A00Z") || !strings.Contains(requests[0], "to=2024-05-02T12%3A00%3A00Z") {
+		t.Errorf("expected the window in the query, got %q", requests[0])
+	}
+	if strings.Contains(requests[0], "next_at") || !strings.Contains(requests[1], "next_at=100") {
+		t.Errorf("expected next_at to be sent from the second page on, got %q", requests)
+	}
+}
+
+func TestFetchEndpointLogs_StopsAtMaxRecords(t *testing.T) {
+	var requests []string
+	c := newLogsServer(t, 250, &requests)
+
+	logs, nextAt, err := fetchEndpointLogs(context.Background(), c, testLogsQuery(150))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(logs) != 150 {
+		t.Fatalf("expected 150 logs, got %d", len(logs))
+	}
+	if nextAt != "150" {
+		t.Errorf("expected next_at %q, got %q", "150", nextAt)
+	}
+	if len(requests) != 2 || !strings.Contains(requests[1], "limit=50") {
+		t.Errorf("expected the last page to be limited to 50, got %q", requests)
+	}
+}
+
+func TestFetchEndpointLogs_LimitIgnored(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"data":[
+			{"timestamp":"2025-04-29T12:39:25.543Z","request_id":"req-0"},
+			{"timestamp":"2025-04-29T12:39:15.627Z","request_id":"req-1"},
+			{"timestamp":"2025-04-29T12:39:15.609Z","request_id":"req-2"}
+		],"next_at":"past-the-page"}`)
+	}))
+	defer server.Close()
+
+	c, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	logs, nextAt, err := fetchEndpointLogs(context.Background(), c, testLogsQuery(2))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(logs) != 2 {
+		t.Fatalf("expected 2 logs, got %d", len(logs))
+	}
+	// The cursor starts at the first dropped entry, as in the API example.
+	if want := "MTc0NTkzMDM1NTYwOTAwMDAwMHxyZXEtMg=="; nextAt != want {
+		t.Errorf("expected next_at %q, got %q", want, nextAt)
+	}
+}
+
+func TestFetchEndpointLogs_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"endpoint not found"}`))
+	}))
+	defer server.Close()
+
+	c, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, _, err := fetchEndpointLogs(context.Background(), c, testLogsQuery(10)); err == nil || !strings.Contains(err.Error(), "status 404") {
+		t.Errorf("expected a status 404 error, got %v", err)
+	}
+}
+
+func TestIsErrorLog(t *testing.T) {
+	ok, notFound, rpcError := 200, 404, -32015
+
+	tests := map[string]struct {
+		log  endpointLogResponse
+		want bool
+	}{
+		"success":    {endpointLogResponse{Status: &ok}, false},
+		"http error": {endpointLogResponse{Status: &notFound}, true},
+		"rpc error":  {endpointLogResponse{Status: &ok, ErrorCode: &rpcError}, true},
+		"no status":  {endpointLogResponse{}, false},
+	}
+	for name, tt := range tests {
+		if got := isErrorLog(tt.log); got != tt.want {
+			t.Errorf("%s: expected %t, got %t", name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &endpointMethodRateLimitResource{}
+	_ resource.ResourceWithConfigure   = &endpointMethodRateLimitResource{}
+	_ resource.ResourceWithImportState = &endpointMethodRateLimitResource{}
+)
+
+// NewEndpointMethodRateLimitResource is a helper function to simplify the provider implementation.
+func NewEndpointMethodRateLimitResource() resource.Resource {
+	return &endpointMethodRateLimitResource{}
+}
+
+// endpointMethodRateLimitResource is the resource implementation.
+type endpointMethodRateLimitResource struct {
+	client *client.Client
+}
+
+// methodRateLimitResponse is used to parse a method rate limit from the raw response body,
+// because the OpenAPI spec only provides examples for the method rate limit responses.
+type methodRateLimitResponse struct {
+	ID       string   `json:"id"`
+	Interval string   `json:"interval"`
+	Methods  []string `json:"methods"`
+	Rate     int64    `json:"rate"`
+	Status   string   `json:"status"`
+}
+
+// Metadata returns the resource type name.
+func (r *endpointMethodRateLimitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_method_rate_limit"
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointMethodRateLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a new endpoint method rate limit in the QuickNode API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the created method rate limit.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to create the method rate limit for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interval": schema.StringAttribute{
+				Description: "The interval the rate applies to. One of `second`, `minute` or `day`. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.CreateMethodRateLimitJSONBodyIntervalSecond),
+						string(api.CreateMethodRateLimitJSONBodyIntervalMinute),
+						string(api.CreateMethodRateLimitJSONBodyIntervalDay),
+					),
+				},
+			},
+			"methods": schema.SetAttribute{
+				Description: "The set of RPC method names the rate limit applies to.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"rate": schema.Int64Attribute{
+				Description: "The maximum number of calls to the methods allowed per interval.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the method rate limit is enforced. (default: true)",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// methodRateLimitStatus converts a Terraform bool to an API "enabled"/"disabled" status.
+func methodRateLimitStatus(enabled bool) api.UpdateMethodRateLimitJSONBodyStatus {
+	if enabled {
+		return api.UpdateMethodRateLimitJSONBodyStatusEnabled
+	}
+	return api.UpdateMethodRateLimitJSONBodyStatusDisabled
+}
+
+// mapMethodRateLimitToState maps a method rate limit from the API to the Terraform resource model.
+func mapMethodRateLimitToState(rl methodRateLimitResponse, state *models.EndpointMethodRateLimitResourceModel) {
+	state.ID = types.StringValue(rl.ID)
+	state.Interval = types.StringValue(rl.Interval)
+	state.Methods = typeutil.FlattenStringSet(rl.Methods)
+	state.Rate = types.Int64Value(rl.Rate)
+	state.Enabled = types.BoolValue(rl.Status == string(api.UpdateMethodRateLimitJSONBodyStatusEnabled))
+}
+
+// Create a new resource.
+func (r *endpointMethodRateLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.EndpointMethodRateLimitResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new method rate limit.
+	createResp, err := r.client.API.CreateMethodRateLimitWithResponse(ctx, plan.EndpointID.ValueString(), api.CreateMethodRateLimitJSONRequestBody{
+		Interval: api.CreateMethodRateLimitJSONBodyInterval(plan.Interval.ValueString()),
+		Methods:  typeutil.ExpandStringSet(ctx, plan.Methods),
+		Rate:     int(plan.Rate.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint method rate limit",
+			"Could not create endpoint method rate limit, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error creating endpoint method rate limit",
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
+		return
+	}
+
+	var rlResp struct {
+		Data methodRateLimitResponse `json:"data"`
+	}
+	if err := json.Unmarshal(createResp.Body, &rlResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing endpoint method rate limit response",
+			"Could not parse response: "+err.Error(),
+		)
+		return
+	}
+
+	if rlResp.Data.ID == "" {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint method rate limit",
+			"API returned an empty response",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(rlResp.Data.ID)
+
+	// Method rate limits are created enabled, so disable it if needed.
+	if !plan.Enabled.ValueBool() {
+		// Track the limiter before disabling it, so a failure taints it
+		// instead of leaving it orphaned in the API.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), plan.EndpointID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		status := methodRateLimitStatus(false)
+		updateResp, err := r.client.API.UpdateMethodRateLimitWithResponse(ctx, plan.EndpointID.ValueString(), plan.ID.ValueString(), api.UpdateMethodRateLimitJSONRequestBody{
+			Status: &status,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error disabling endpoint method rate limit",
+				"Could not disable endpoint method rate limit, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if updateResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
+				"Error disabling endpoint method rate limit",
+				client.NewAPIError(updateResp.HTTPResponse, updateResp.Body),
+			))
+			return
+		}
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *endpointMethodRateLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.EndpointMethodRateLimitResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The limits of an archived endpoint may still be listed, so check the
+	// endpoint itself first.
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint",
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
+		return
+	}
+
+	// Get refreshed method rate limits from QuickNode.
+	listResp, err := r.client.API.GetMethodRateLimitsWithResponse(ctx, state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint Method Rate Limits",
+			"Could not read method rate limits of QuickNode endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if listResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if listResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint Method Rate Limits",
+			client.NewAPIError(listResp.HTTPResponse, listResp.Body),
+		))
+		return
+	}
+
+	// The GetMethodRateLimits response doesn't have a typed JSON200 in the spec, so we parse the raw body.
+	var rlResp struct {
+		Data struct {
+			RateLimiters []methodRateLimitResponse `json:"rate_limiters"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(listResp.Body, &rlResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing endpoint method rate limits response",
+			"Could not parse response: "+err.Error(),
+		)
+		return
+	}
+
+	// Find specific method rate limit by ID.
+	found := false
+	for _, rl := range rlResp.Data.RateLimiters {
+		if rl.ID == state.ID.ValueString() {
+			found = true
+			mapMethodRateLimitToState(rl, &state)
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *endpointMethodRateLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan.
+	var plan models.EndpointMethodRateLimitResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods := typeutil.ExpandStringSet(ctx, plan.Methods)
+	rate := int(plan.Rate.ValueInt64())
+	status := methodRateLimitStatus(plan.Enabled.ValueBool())
+
+	// Update method rate limit.
+	updateResp, err := r.client.API.UpdateMethodRateLimitWithResponse(ctx, plan.EndpointID.ValueString(), plan.ID.ValueString(), api.UpdateMethodRateLimitJSONRequestBody{
+		Methods: &methods,
+		Rate:    &rate,
+		Status:  &status,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating QuickNode Endpoint Method Rate Limit",
+			"Could not update endpoint method rate limit, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if updateResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Updating QuickNode Endpoint Method Rate Limit",
+			client.NewAPIError(updateResp.HTTPResponse, updateResp.Body),
+		))
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *endpointMethodRateLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.EndpointMethodRateLimitResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing method rate limit.
+	deleteResp, err := r.client.API.DeleteMethodRateLimitWithResponse(ctx, state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint Method Rate Limit",
+			"Could not delete endpoint method rate limit, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if deleteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Endpoint Method Rate Limit",
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *endpointMethodRateLimitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *endpointMethodRateLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: endpoint_id/method_rate_limit_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
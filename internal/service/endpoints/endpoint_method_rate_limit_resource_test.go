@@ -0,0 +1,85 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEndpointMethodRateLimitResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccEndpointMethodRateLimitResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_method_rate_limit.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "interval", "second"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "methods.#", "1"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "rate", "5"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "quicknode_endpoint_method_rate_limit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["quicknode_endpoint_method_rate_limit.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					return rs.Primary.Attributes["endpoint_id"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+			// Update testing.
+			{
+				Config: testAccEndpointMethodRateLimitResourceConfigUpdated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "methods.#", "2"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "rate", "10"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_method_rate_limit.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEndpointMethodRateLimitResourceConfig = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+}
+
+resource "quicknode_endpoint_method_rate_limit" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+  interval    = "second"
+  methods     = ["eth_getLogs"]
+  rate        = 5
+}
+`
+
+const testAccEndpointMethodRateLimitResourceConfigUpdated = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+}
+
+resource "quicknode_endpoint_method_rate_limit" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+  interval    = "second"
+  methods     = ["eth_getLogs", "debug_traceTransaction"]
+  rate        = 10
+  enabled     = false
+}
+`
@@ -0,0 +1,338 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &endpointRateLimitsResource{}
+	_ resource.ResourceWithConfigure        = &endpointRateLimitsResource{}
+	_ resource.ResourceWithConfigValidators = &endpointRateLimitsResource{}
+	_ resource.ResourceWithImportState      = &endpointRateLimitsResource{}
+)
+
+// NewEndpointRateLimitsResource is a helper function to simplify the provider implementation.
+func NewEndpointRateLimitsResource() resource.Resource {
+	return &endpointRateLimitsResource{}
+}
+
+// endpointRateLimitsResource is the resource implementation.
+type endpointRateLimitsResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *endpointRateLimitsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_rate_limits"
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointRateLimitsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the rate limits of an endpoint in the QuickNode API. " +
+			"Only one rate limits resource should be declared per endpoint. " +
+			"Destroying the resource resets all rate limits of the endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the rate limits. This is the same as the endpoint ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to manage the rate limits for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rps": schema.Int64Attribute{
+				Description: "The maximum number of requests per second.",
+				Optional:    true,
+			},
+			"rpm": schema.Int64Attribute{
+				Description: "The maximum number of requests per minute.",
+				Optional:    true,
+			},
+			"rpd": schema.Int64Attribute{
+				Description: "The maximum number of requests per day.",
+				Optional:    true,
+			},
+			"rate_limit_by_ip": schema.BoolAttribute{
+				Description: "Whether the rate limits are applied per client IP.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account": schema.Int64Attribute{
+				Description: "The account-wide rate limit that applies to the endpoint.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators returns the validators for the resource configuration.
+func (r *endpointRateLimitsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("rps"),
+			path.MatchRoot("rpm"),
+			path.MatchRoot("rpd"),
+		),
+	}
+}
+
+// buildRateLimitsBody builds the request body for updating rate limits.
+func buildRateLimitsBody(tf *models.EndpointRateLimitsResourceModel) api.UpdateRateLimitsJSONRequestBody {
+	var body api.UpdateRateLimitsJSONRequestBody
+	body.RateLimits.Rps = int64PointerToInt(tf.RPS)
+	body.RateLimits.Rpm = int64PointerToInt(tf.RPM)
+	body.RateLimits.Rpd = int64PointerToInt(tf.RPD)
+	return body
+}
+
+// int64PointerToInt converts a Terraform int64 to an *int, returning nil when unset.
+func int64PointerToInt(val types.Int64) *int {
+	if val.IsNull() || val.IsUnknown() {
+		return nil
+	}
+	v := int(val.ValueInt64())
+	return &v
+}
+
+// flattenRateLimit converts an API rate limit to a Terraform int64. A zero value is
+// treated as unset when the attribute is not managed, so that unset limits don't drift,
+// and a limit missing from the response keeps the current value.
+func flattenRateLimit(current types.Int64, val *int) types.Int64 {
+	if val == nil {
+		if current.IsUnknown() {
+			return types.Int64Null()
+		}
+		return current
+	}
+	if *val == 0 && current.IsNull() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*val))
+}
+
+// updateRateLimits sends the rate limits in the model to the QuickNode API.
+func (r *endpointRateLimitsResource) updateRateLimits(ctx context.Context, endpointID string, body api.UpdateRateLimitsJSONRequestBody) error {
+	updateResp, err := r.client.API.UpdateRateLimitsWithResponse(ctx, endpointID, body)
+	if err != nil {
+		return err
+	}
+	if updateResp.StatusCode() != http.StatusOK {
+		return client.NewAPIError(updateResp.HTTPResponse, updateResp.Body)
+	}
+	return nil
+}
+
+// readRateLimits reads the rate limits of the endpoint into the model.
+func (r *endpointRateLimitsResource) readRateLimits(ctx context.Context, state *models.EndpointRateLimitsResourceModel) error {
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, state.EndpointID.ValueString())
+	if err != nil {
+		return err
+	}
+	if endpointGone(showResp) {
+		return errEndpointGone
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		return client.NewAPIError(showResp.HTTPResponse, showResp.Body)
+	}
+
+	limits := showResp.JSON200.Data.RateLimits
+	if limits == nil {
+		limits = &api.EndpointRateLimits{}
+	}
+
+	state.ID = types.StringValue(showResp.JSON200.Data.Id)
+	state.RPS = flattenRateLimit(state.RPS, limits.Rps)
+	state.RPM = flattenRateLimit(state.RPM, limits.Rpm)
+	state.RPD = flattenRateLimit(state.RPD, limits.Rpd)
+	state.RateLimitByIP = types.BoolValue(limits.RateLimitByIp != nil && *limits.RateLimitByIp)
+	account := int64(0)
+	if limits.Account != nil {
+		account = int64(*limits.Account)
+	}
+	state.Account = types.Int64Value(account)
+	return nil
+}
+
+// Create a new resource.
+func (r *endpointRateLimitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.EndpointRateLimitsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set rate limits.
+	if err := r.updateRateLimits(ctx, plan.EndpointID.ValueString(), buildRateLimitsBody(&plan)); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error setting endpoint rate limits", err))
+		return
+	}
+
+	// Read back the rate limits.
+	if err := r.readRateLimits(ctx, &plan); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Reading QuickNode Endpoint", err))
+		return
+	}
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *endpointRateLimitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.EndpointRateLimitsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed rate limits from QuickNode.
+	if err := r.readRateLimits(ctx, &state); err != nil {
+		if errors.Is(err, errEndpointGone) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Reading QuickNode Endpoint", err))
+		return
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *endpointRateLimitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state.
+	var plan, state models.EndpointRateLimitsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Limits removed from the configuration are reset rather than left in place.
+	body := buildRateLimitsBody(&plan)
+	zero := 0
+	if body.RateLimits.Rps == nil && !state.RPS.IsNull() {
+		body.RateLimits.Rps = &zero
+	}
+	if body.RateLimits.Rpm == nil && !state.RPM.IsNull() {
+		body.RateLimits.Rpm = &zero
+	}
+	if body.RateLimits.Rpd == nil && !state.RPD.IsNull() {
+		body.RateLimits.Rpd = &zero
+	}
+
+	if err := r.updateRateLimits(ctx, plan.EndpointID.ValueString(), body); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Updating QuickNode Endpoint Rate Limits", err))
+		return
+	}
+
+	// Read back the rate limits.
+	if err := r.readRateLimits(ctx, &plan); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Reading QuickNode Endpoint", err))
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *endpointRateLimitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.EndpointRateLimitsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reset all rate limits of the endpoint.
+	zero := 0
+	var body api.UpdateRateLimitsJSONRequestBody
+	body.RateLimits.Rps = &zero
+	body.RateLimits.Rpm = &zero
+	body.RateLimits.Rpd = &zero
+	if err := r.updateRateLimits(ctx, state.EndpointID.ValueString(), body); err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Resetting QuickNode Endpoint Rate Limits", err))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *endpointRateLimitsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *endpointRateLimitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The rate limits are identified by the endpoint they belong to.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
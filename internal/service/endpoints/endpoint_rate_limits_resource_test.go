@@ -0,0 +1,71 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointRateLimitsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccEndpointRateLimitsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_rate_limits.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rps", "10"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rpm", "300"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "quicknode_endpoint_rate_limits.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing.
+			{
+				Config: testAccEndpointRateLimitsResourceConfigUpdated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rps", "20"),
+					resource.TestCheckNoResourceAttr("quicknode_endpoint_rate_limits.test", "rpm"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_rate_limits.test", "rpd", "100000"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEndpointRateLimitsResourceConfig = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+}
+
+resource "quicknode_endpoint_rate_limits" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+  rps         = 10
+  rpm         = 300
+}
+`
+
+const testAccEndpointRateLimitsResourceConfigUpdated = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+}
+
+resource "quicknode_endpoint_rate_limits" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+  rps         = 20
+  rpd         = 100000
+}
+`
@@ -0,0 +1,36 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenRateLimit(t *testing.T) {
+	zero, ten := 0, 10
+
+	tests := []struct {
+		name    string
+		current types.Int64
+		val     *int
+		want    types.Int64
+	}{
+		{name: "set", current: types.Int64Value(5), val: &ten, want: types.Int64Value(10)},
+		{name: "unmanaged", current: types.Int64Null(), val: &ten, want: types.Int64Value(10)},
+		{name: "unmanaged zero", current: types.Int64Null(), val: &zero, want: types.Int64Null()},
+		{name: "managed zero", current: types.Int64Value(0), val: &zero, want: types.Int64Value(0)},
+		{name: "missing keeps configured", current: types.Int64Value(5), val: nil, want: types.Int64Value(5)},
+		{name: "missing unmanaged", current: types.Int64Null(), val: nil, want: types.Int64Null()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := flattenRateLimit(tt.current, tt.val); !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &endpointReferrerResource{}
+	_ resource.ResourceWithConfigure   = &endpointReferrerResource{}
+	_ resource.ResourceWithImportState = &endpointReferrerResource{}
+)
+
+// NewEndpointReferrerResource is a helper function to simplify the provider implementation.
+func NewEndpointReferrerResource() resource.Resource {
+	return &endpointReferrerResource{}
+}
+
+// endpointReferrerResource is the resource implementation.
+type endpointReferrerResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *endpointReferrerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_referrer"
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointReferrerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a new endpoint referrer in the QuickNode API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the created endpoint referrer.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"referrer": schema.StringAttribute{
+				Description: "The referrer (origin) allowed to access the endpoint.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to add the referrer to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *endpointReferrerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.EndpointReferrerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	referrer := plan.Referrer.ValueString()
+	// Create new referrer.
+	createResp, err := r.client.API.CreateReferrerWithResponse(ctx, plan.EndpointID.ValueString(), api.CreateReferrerJSONRequestBody{
+		Referrer: &referrer,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating endpoint referrer",
+			"Could not create endpoint referrer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error creating endpoint referrer",
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
+		return
+	}
+
+	// The CreateReferrer response doesn't have a typed JSON200 in the spec, so we parse the raw body.
+	var refResp struct {
+		Data struct {
+			ID       string `json:"id"`
+			Referrer string `json:"referrer"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(createResp.Body, &refResp); err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing endpoint referrer response",
+			"Could not parse response: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(refResp.Data.ID)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *endpointReferrerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.EndpointReferrerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed endpoint value from QuickNode.
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint",
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
+		return
+	}
+
+	endpoint := showResp.JSON200.Data
+
+	// Find specific referrer in referrers array.
+	found := false
+	if endpoint.Security.Referrers != nil {
+		for _, ref := range *endpoint.Security.Referrers {
+			if ref.Id != nil && *ref.Id == state.ID.ValueString() {
+				found = true
+				if ref.Referrer != nil {
+					state.Referrer = types.StringValue(*ref.Referrer)
+				}
+				break
+			}
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *endpointReferrerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Endpoint referrers cannot be updated in-place. This is a bug in the provider.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *endpointReferrerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.EndpointReferrerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing referrer.
+	deleteResp, err := r.client.API.DeleteReferrerWithResponse(ctx, state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint Referrer",
+			"Could not delete endpoint referrer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if deleteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Endpoint Referrer",
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *endpointReferrerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *endpointReferrerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: endpoint_id/referrer_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
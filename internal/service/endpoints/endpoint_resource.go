@@ -6,6 +6,7 @@ package endpoints
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -13,14 +14,17 @@ import (
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,6 +33,7 @@ var (
 	_ resource.Resource                = &endpointResource{}
 	_ resource.ResourceWithConfigure   = &endpointResource{}
 	_ resource.ResourceWithImportState = &endpointResource{}
+	_ resource.ResourceWithModifyPlan  = &endpointResource{}
 )
 
 // NewEndpointResource is a helper function to simplify the provider implementation.
@@ -64,7 +69,7 @@ func (r *endpointResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"chain": schema.StringAttribute{
-				Description: "The blockchain the endpoint is associated with.",
+				Description: "The blockchain the endpoint is associated with. Validated against the QuickNode chains catalog at plan time.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -72,7 +77,7 @@ func (r *endpointResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"network": schema.StringAttribute{
-				Description: "The specific network of the blockchain.",
+				Description: "The specific network of the blockchain. Validated against the QuickNode chains catalog at plan time.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -148,16 +153,35 @@ func (r *endpointResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"ip_custom_header": schema.StringAttribute{
+				Description: "The name of the request header that carries the client IP, such as `X-Forwarded-For`. " +
+					"When set, IP whitelisting uses this header instead of the connecting IP, which is needed when traffic goes through a CDN or load balancer.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"status": schema.StringAttribute{
-				Description: "The status of the endpoint.",
+				Description: "The status of the endpoint. Set to `active` or `paused` to resume or pause the endpoint.",
+				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.Active),
+						string(api.Paused),
+					),
+				},
 			},
 			"multichain": schema.BoolAttribute{
-				Description: "Whether the endpoint is multichain.",
+				Description: "Whether the endpoint is multichain. Set to `true` to serve multiple chains from the same endpoint.",
+				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"tags": schema.ListAttribute{
 				Description: "Labels (tags) associated with the endpoint.",
@@ -192,6 +216,9 @@ type securityOptionsResponse struct {
 		HSTS           *bool `json:"hsts"`
 		CORS           *bool `json:"cors"`
 		RequestFilters *bool `json:"requestFilters"`
+		IPCustomHeader *struct {
+			Value *string `json:"value"`
+		} `json:"ipCustomHeader"`
 	} `json:"options"`
 }
 
@@ -219,6 +246,24 @@ func parseSecurityOptions(body []byte) *models.SecurityOptionsResourceModel {
 	}
 }
 
+// parseIPCustomHeader extracts the IP custom header name from the raw JSON body,
+// returning null when no custom header is configured.
+func parseIPCustomHeader(body []byte) types.String {
+	var envelope struct {
+		Data struct {
+			Security securityOptionsResponse `json:"security"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return types.StringNull()
+	}
+	header := envelope.Data.Security.Options.IPCustomHeader
+	if header == nil || header.Value == nil || *header.Value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*header.Value)
+}
+
 func defaultSecurityOptions() *models.SecurityOptionsResourceModel {
 	return &models.SecurityOptionsResourceModel{
 		Tokens:         types.BoolValue(true),
@@ -260,7 +305,7 @@ func reconcileTags(ctx context.Context, c *client.Client, endpointID string, pla
 		return fmt.Errorf("listing endpoint tags: %w", err)
 	}
 	if listResp.StatusCode() != http.StatusOK {
-		return fmt.Errorf("listing endpoint tags: status %d: %s", listResp.StatusCode(), string(listResp.Body))
+		return fmt.Errorf("listing endpoint tags: %w", client.NewAPIError(listResp.HTTPResponse, listResp.Body))
 	}
 
 	// Build desired label set from plan.
@@ -286,7 +331,7 @@ func reconcileTags(ctx context.Context, c *client.Client, endpointID string, pla
 					return fmt.Errorf("deleting tag %d: %w", *t.TagId, delErr)
 				}
 				if delResp.StatusCode() != http.StatusOK {
-					return fmt.Errorf("deleting tag %d: status %d", *t.TagId, delResp.StatusCode())
+					return fmt.Errorf("deleting tag %d: %w", *t.TagId, client.NewAPIError(delResp.HTTPResponse, delResp.Body))
 				}
 			}
 		}
@@ -309,15 +354,98 @@ func reconcileTags(ctx context.Context, c *client.Client, endpointID string, pla
 				return fmt.Errorf("creating tag %q: %w", label, createErr)
 			}
 			if createResp.StatusCode() != http.StatusOK {
-				return fmt.Errorf("creating tag %q: status %d", label, createResp.StatusCode())
+				return fmt.Errorf("creating tag %q: %w", label, client.NewAPIError(createResp.HTTPResponse, createResp.Body))
 			}
 		}
 	}
 	return nil
 }
 
+// endpointStatusArchived is the status of an endpoint archived outside of Terraform.
+const endpointStatusArchived = "archived"
+
+// errEndpointGone is returned when the endpoint was deleted or archived.
+var errEndpointGone = errors.New("endpoint not found or archived")
+
+// endpointGone reports whether a ShowEndpoint response means the endpoint no
+// longer exists, so resources of the endpoint should be removed from the state.
+func endpointGone(showResp *api.ShowEndpointResponse) bool {
+	switch showResp.StatusCode() {
+	case http.StatusNotFound:
+		return true
+	case http.StatusOK:
+		data := showResp.JSON200
+		return data != nil && data.Data != nil && data.Data.Status != nil && *data.Data.Status == endpointStatusArchived
+	}
+	return false
+}
+
+// updateEndpointStatus pauses or resumes the endpoint.
+func updateEndpointStatus(ctx context.Context, c *client.Client, endpointID string, status string) error {
+	statusResp, err := c.API.UpdateEndpointStatusWithResponse(ctx, endpointID, api.UpdateEndpointStatusJSONRequestBody{
+		Status: api.UpdateEndpointStatusJSONBodyStatus(status),
+	})
+	if err != nil {
+		return fmt.Errorf("updating endpoint status: %w", err)
+	}
+	if statusResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("updating endpoint status: %w", client.NewAPIError(statusResp.HTTPResponse, statusResp.Body))
+	}
+	return nil
+}
+
+// updateEndpointMultichain enables or disables multichain on the endpoint.
+func updateEndpointMultichain(ctx context.Context, c *client.Client, endpointID string, enabled bool) error {
+	if enabled {
+		enableResp, err := c.API.EnableMultichainWithResponse(ctx, endpointID)
+		if err != nil {
+			return fmt.Errorf("enabling multichain: %w", err)
+		}
+		if enableResp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("enabling multichain: %w", client.NewAPIError(enableResp.HTTPResponse, enableResp.Body))
+		}
+		return nil
+	}
+
+	disableResp, err := c.API.DisableMultichainWithResponse(ctx, endpointID)
+	if err != nil {
+		return fmt.Errorf("disabling multichain: %w", err)
+	}
+	if disableResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("disabling multichain: %w", client.NewAPIError(disableResp.HTTPResponse, disableResp.Body))
+	}
+	return nil
+}
+
+// reconcileIPCustomHeader sets or deletes the IP custom header of the endpoint.
+func reconcileIPCustomHeader(ctx context.Context, c *client.Client, endpointID string, customHeader types.String) error {
+	if customHeader.ValueString() == "" {
+		deleteResp, err := c.API.DeleteIpCustomHeaderWithResponse(ctx, endpointID)
+		if err != nil {
+			return fmt.Errorf("deleting IP custom header: %w", err)
+		}
+		if deleteResp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("deleting IP custom header: %w", client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body))
+		}
+		return nil
+	}
+
+	updateResp, err := c.API.CreateOrUpdateIpCustomHeaderWithResponse(ctx, endpointID, api.CreateOrUpdateIpCustomHeaderJSONRequestBody{
+		HeaderName: customHeader.ValueString(),
+	})
+	if err != nil {
+		return fmt.Errorf("setting IP custom header: %w", err)
+	}
+	if updateResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("setting IP custom header: %w", client.NewAPIError(updateResp.HTTPResponse, updateResp.Body))
+	}
+	return nil
+}
+
 // buildSecurityOptionsBody builds the request body for updating security options.
-func buildSecurityOptionsBody(tf *models.SecurityOptionsResourceModel) api.UpdateSecurityOptionsJSONRequestBody {
+// The IP custom header option is enabled whenever a custom header name is configured.
+func buildSecurityOptionsBody(tf *models.SecurityOptionsResourceModel, customHeader types.String) api.UpdateSecurityOptionsJSONRequestBody {
+	ipCustomHeader := api.UpdateSecurityOptionsJSONBodyOptionsIpCustomHeader(securityString(customHeader.ValueString() != ""))
 	if tf == nil {
 		tokens := api.UpdateSecurityOptionsJSONBodyOptionsTokens("enabled")
 		referrers := api.UpdateSecurityOptionsJSONBodyOptionsReferrers("disabled")
@@ -347,6 +475,7 @@ func buildSecurityOptionsBody(tf *models.SecurityOptionsResourceModel) api.Updat
 				Hsts:           &hsts,
 				Cors:           &cors,
 				RequestFilters: &requestFilters,
+				IpCustomHeader: &ipCustomHeader,
 			},
 		}
 	}
@@ -379,6 +508,7 @@ func buildSecurityOptionsBody(tf *models.SecurityOptionsResourceModel) api.Updat
 			Hsts:           &hsts,
 			Cors:           &cors,
 			RequestFilters: &requestFilters,
+			IpCustomHeader: &ipCustomHeader,
 		},
 	}
 }
@@ -410,6 +540,7 @@ func mapSingleEndpointToState(endpoint *api.SingleEndpoint, body []byte) models.
 		HTTPURL:         types.StringValue(endpoint.HttpUrl),
 		WSSURL:          types.StringValue(wssURL),
 		SecurityOptions: parseSecurityOptions(body),
+		IPCustomHeader:  parseIPCustomHeader(body),
 		Status:          types.StringValue(status),
 		Multichain:      types.BoolValue(multichain),
 		Tags:            parseTags(endpoint.Tags),
@@ -443,10 +574,10 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 	if createResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error creating endpoint",
-			fmt.Sprintf("API returned status %d: %s", createResp.StatusCode(), string(createResp.Body)),
-		)
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
 		return
 	}
 
@@ -458,10 +589,6 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 	if endpoint.WssUrl != nil {
 		plan.WSSURL = types.StringValue(*endpoint.WssUrl)
 	}
-	if endpoint.Status != nil {
-		plan.Status = types.StringValue(*endpoint.Status)
-	}
-	plan.Multichain = types.BoolValue(*endpoint.Multichain)
 
 	// Patch endpoint label if needed.
 	if plan.Label.ValueString() != "" {
@@ -477,18 +604,26 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 			return
 		}
 		if updateResp.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
 				"Error patching endpoint label",
-				fmt.Sprintf("API returned status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
-			)
+				client.NewAPIError(updateResp.HTTPResponse, updateResp.Body),
+			))
 			return
 		}
 	} else if endpoint.Label != nil {
 		plan.Label = types.StringValue(*endpoint.Label)
 	}
 
+	// Set the IP custom header before enabling it in the security options.
+	if plan.IPCustomHeader.ValueString() != "" {
+		if headerErr := reconcileIPCustomHeader(ctx, r.client, plan.ID.ValueString(), plan.IPCustomHeader); headerErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error setting endpoint IP custom header", headerErr))
+			return
+		}
+	}
+
 	// Patch security options.
-	secBody := buildSecurityOptionsBody(plan.SecurityOptions)
+	secBody := buildSecurityOptionsBody(plan.SecurityOptions, plan.IPCustomHeader)
 	secResp, err := r.client.API.UpdateSecurityOptionsWithResponse(ctx, plan.ID.ValueString(), secBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -498,13 +633,29 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 	if secResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error patching endpoint security options",
-			fmt.Sprintf("API returned status %d: %s", secResp.StatusCode(), string(secResp.Body)),
-		)
+			client.NewAPIError(secResp.HTTPResponse, secResp.Body),
+		))
 		return
 	}
 
+	// Pause or resume the endpoint if a different status is requested.
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() && (endpoint.Status == nil || plan.Status.ValueString() != *endpoint.Status) {
+		if statusErr := updateEndpointStatus(ctx, r.client, plan.ID.ValueString(), plan.Status.ValueString()); statusErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error updating endpoint status", statusErr))
+			return
+		}
+	}
+
+	// Enable or disable multichain if it differs from the created endpoint.
+	if !plan.Multichain.IsNull() && !plan.Multichain.IsUnknown() && (endpoint.Multichain == nil || plan.Multichain.ValueBool() != *endpoint.Multichain) {
+		if mcErr := updateEndpointMultichain(ctx, r.client, plan.ID.ValueString(), plan.Multichain.ValueBool()); mcErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error updating endpoint multichain", mcErr))
+			return
+		}
+	}
+
 	// Read back the full state including security options.
 	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, plan.ID.ValueString())
 	if err != nil {
@@ -515,10 +666,10 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 	plan.SecurityOptions = parseSecurityOptions(showResp.Body)
@@ -526,7 +677,7 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 	// Reconcile tags if specified.
 	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		if tagErr := reconcileTags(ctx, r.client, plan.ID.ValueString(), plan.Tags); tagErr != nil {
-			resp.Diagnostics.AddError("Error creating endpoint tags", tagErr.Error())
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error creating endpoint tags", tagErr))
 			return
 		}
 		// Re-read to get final state including tags.
@@ -539,10 +690,10 @@ func (r *endpointResource) Create(ctx context.Context, req resource.CreateReques
 			return
 		}
 		if showResp.StatusCode() != http.StatusOK {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
 				"Error Reading QuickNode Endpoint",
-				fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-			)
+				client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+			))
 			return
 		}
 	}
@@ -576,11 +727,15 @@ func (r *endpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 		)
 		return
 	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 
@@ -597,10 +752,11 @@ func (r *endpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *endpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan.
-	var plan models.EndpointResourceModel
+	// Retrieve values from plan and state.
+	var plan, state models.EndpointResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -618,15 +774,24 @@ func (r *endpointResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 	if updateResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Updating QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
-		)
+			client.NewAPIError(updateResp.HTTPResponse, updateResp.Body),
+		))
 		return
 	}
 
+	// Set the IP custom header before enabling it in the security options.
+	headerChanged := plan.IPCustomHeader.ValueString() != state.IPCustomHeader.ValueString()
+	if headerChanged && plan.IPCustomHeader.ValueString() != "" {
+		if headerErr := reconcileIPCustomHeader(ctx, r.client, plan.ID.ValueString(), plan.IPCustomHeader); headerErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error setting endpoint IP custom header", headerErr))
+			return
+		}
+	}
+
 	// Patch security options.
-	secBody := buildSecurityOptionsBody(plan.SecurityOptions)
+	secBody := buildSecurityOptionsBody(plan.SecurityOptions, plan.IPCustomHeader)
 	secResp, err := r.client.API.UpdateSecurityOptionsWithResponse(ctx, plan.ID.ValueString(), secBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -636,16 +801,40 @@ func (r *endpointResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 	if secResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error patching endpoint security options",
-			fmt.Sprintf("API returned status %d: %s", secResp.StatusCode(), string(secResp.Body)),
-		)
+			client.NewAPIError(secResp.HTTPResponse, secResp.Body),
+		))
 		return
 	}
 
+	// Delete the IP custom header once it is disabled in the security options.
+	if headerChanged && plan.IPCustomHeader.ValueString() == "" {
+		if headerErr := reconcileIPCustomHeader(ctx, r.client, plan.ID.ValueString(), plan.IPCustomHeader); headerErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error deleting endpoint IP custom header", headerErr))
+			return
+		}
+	}
+
+	// Pause or resume the endpoint if the status changed.
+	if !plan.Status.IsNull() && !plan.Status.IsUnknown() && plan.Status.ValueString() != state.Status.ValueString() {
+		if statusErr := updateEndpointStatus(ctx, r.client, plan.ID.ValueString(), plan.Status.ValueString()); statusErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error updating endpoint status", statusErr))
+			return
+		}
+	}
+
+	// Enable or disable multichain if it changed.
+	if !plan.Multichain.IsNull() && !plan.Multichain.IsUnknown() && plan.Multichain.ValueBool() != state.Multichain.ValueBool() {
+		if mcErr := updateEndpointMultichain(ctx, r.client, plan.ID.ValueString(), plan.Multichain.ValueBool()); mcErr != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error updating endpoint multichain", mcErr))
+			return
+		}
+	}
+
 	// Reconcile tags.
 	if tagErr := reconcileTags(ctx, r.client, plan.ID.ValueString(), plan.Tags); tagErr != nil {
-		resp.Diagnostics.AddError("Error updating endpoint tags", tagErr.Error())
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error updating endpoint tags", tagErr))
 		return
 	}
 
@@ -659,10 +848,10 @@ func (r *endpointResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 
@@ -691,13 +880,54 @@ func (r *endpointResource) Delete(ctx context.Context, req resource.DeleteReques
 		)
 		return
 	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
 	if deleteResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Deleting QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// ModifyPlan validates the chain and network against the chains catalog, so a
+// typo fails the plan instead of the apply.
+func (r *endpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var chain, network types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("chain"), &chain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("network"), &network)...)
+	if resp.Diagnostics.HasError() || chain.IsUnknown() || network.IsUnknown() {
+		return
+	}
+
+	// Only validate new values, so existing endpoints keep planning if their
+	// network is later removed from the catalog.
+	if !req.State.Raw.IsNull() {
+		var stateChain, stateNetwork types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("chain"), &stateChain)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("network"), &stateNetwork)...)
+		if resp.Diagnostics.HasError() || (chain.Equal(stateChain) && network.Equal(stateNetwork)) {
+			return
+		}
+	}
+
+	chains, err := r.client.Chains(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Validate Chain and Network",
+			"Could not fetch the QuickNode chains catalog, so the chain and network are only validated when the endpoint is created: "+err.Error(),
 		)
 		return
 	}
+
+	resp.Diagnostics.Append(validateChainNetwork(chains, chain.ValueString(), network.ValueString())...)
 }
 
 // Configure adds the provider configured client to the resource.
@@ -0,0 +1,148 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccEndpointResourceConfig("active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint.test", "id"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint.test", "http_url"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "chain", "optimism"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "network", "optimism-sepolia"),
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "status", "active"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "quicknode_endpoint.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Pause testing.
+			{
+				Config: testAccEndpointResourceConfig("paused"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "status", "paused"),
+				),
+			},
+			// Resume testing.
+			{
+				Config: testAccEndpointResourceConfig("active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "status", "active"),
+				),
+			},
+			// Multichain testing.
+			{
+				Config: testAccEndpointResourceConfigMultichain(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "multichain", "true"),
+				),
+			},
+			{
+				Config: testAccEndpointResourceConfigMultichain(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "multichain", "false"),
+				),
+			},
+			// IP custom header testing.
+			{
+				Config: testAccEndpointResourceConfigIPCustomHeader("X-Forwarded-For"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "ip_custom_header", "X-Forwarded-For"),
+				),
+			},
+			{
+				Config: testAccEndpointResourceConfigIPCustomHeader("X-Real-IP"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_endpoint.test", "ip_custom_header", "X-Real-IP"),
+				),
+			},
+			{
+				Config: testAccEndpointResourceConfig("active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("quicknode_endpoint.test", "ip_custom_header"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEndpointResource_InvalidChainNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEndpointResourceConfigChainNetwork("optimsm", "optimism-sepolia"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean "optimism"\?`),
+			},
+			{
+				Config:      testAccEndpointResourceConfigChainNetwork("optimism", "optimism-sepolai"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Did you mean "optimism-sepolia"\?`),
+			},
+		},
+	})
+}
+
+func testAccEndpointResourceConfig(status string) string {
+	return fmt.Sprintf(`
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "tf-acc-test"
+  status  = %q
+}
+`, status)
+}
+
+func testAccEndpointResourceConfigMultichain(multichain bool) string {
+	return fmt.Sprintf(`
+resource "quicknode_endpoint" "test" {
+  chain      = "optimism"
+  network    = "optimism-sepolia"
+  label      = "tf-acc-test"
+  multichain = %t
+}
+`, multichain)
+}
+
+func testAccEndpointResourceConfigIPCustomHeader(header string) string {
+	return fmt.Sprintf(`
+resource "quicknode_endpoint" "test" {
+  chain            = "optimism"
+  network          = "optimism-sepolia"
+  label            = "tf-acc-test"
+  ip_custom_header = %q
+}
+`, header)
+}
+
+func testAccEndpointResourceConfigChainNetwork(chain, network string) string {
+	return fmt.Sprintf(`
+resource "quicknode_endpoint" "test" {
+  chain   = %q
+  network = %q
+  label   = "tf-acc-test"
+}
+`, chain, network)
+}
@@ -0,0 +1,265 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &endpointTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &endpointTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &endpointTokenEphemeralResource{}
+)
+
+// revokeTokenPrivateKey is the private data key of a token to delete on close.
+const revokeTokenPrivateKey = "revoke_token"
+
+// revokeToken identifies a token created by the ephemeral resource.
+type revokeToken struct {
+	EndpointID string `json:"endpoint_id"`
+	TokenID    string `json:"token_id"`
+}
+
+// NewEndpointTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewEndpointTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &endpointTokenEphemeralResource{}
+}
+
+// endpointTokenEphemeralResource is the ephemeral resource implementation.
+type endpointTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *endpointTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *endpointTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates or fetches an endpoint authentication token for the duration of a Terraform run, without storing it in the state or plan. " +
+			"Requires Terraform 1.10 or later. Without `token_id`, a new token is created every time Terraform opens the ephemeral resource, " +
+			"which happens on both plan and apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the token.",
+				Computed:    true,
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint of the token.",
+				Required:    true,
+			},
+			"token_id": schema.StringAttribute{
+				Description: "The ID of an existing token to fetch. When unset, a new token is created.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Whether to delete the created token once Terraform no longer needs it, at the end of the run. " +
+					"Set it to false to keep the token, which is then left in the API after the run and must be deleted outside of Terraform. " +
+					"Cannot be set with `token_id`. (default: true)",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("token_id")),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The authentication token value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"http_url": schema.StringAttribute{
+				Description: "The HTTP URL of the endpoint, which embeds an authentication token of the endpoint.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"wss_url": schema.StringAttribute{
+				Description: "The WebSocket URL of the endpoint, which embeds an authentication token of the endpoint.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Open creates or fetches the token.
+func (r *endpointTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// Retrieve values from config.
+	var data models.EndpointTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	endpointID := data.EndpointID.ValueString()
+
+	// Read the endpoint for its URLs and, when fetching, the token, before
+	// creating anything.
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, endpointID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode endpoint ID "+endpointID+": "+err.Error(),
+		)
+		return
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint",
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
+		return
+	}
+	endpoint := showResp.JSON200.Data
+	data.HTTPURL = types.StringValue(endpoint.HttpUrl)
+	data.WSSURL = types.StringPointerValue(endpoint.WssUrl)
+
+	// Fetch an existing token.
+	if !data.TokenID.IsNull() {
+		tokenID := data.TokenID.ValueString()
+		token := findEndpointToken(endpoint, tokenID)
+		if token == nil || token.Token == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_id"),
+				"Endpoint Token Not Found",
+				fmt.Sprintf("Endpoint %s has no token with ID %s.", endpointID, tokenID),
+			)
+			return
+		}
+		data.ID = types.StringValue(tokenID)
+		data.Token = types.StringValue(*token.Token)
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	// Create a new token.
+	token, err := createEndpointToken(ctx, r.client.API, endpointID)
+	if err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error creating endpoint token", err))
+		return
+	}
+	tokenID := *token.Id
+	data.ID = types.StringValue(tokenID)
+	data.Token = types.StringValue(*token.Token)
+
+	// Close isn't called when Open fails, so delete the token here instead
+	// of leaking it.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := deleteEndpointToken(ctx, r.client.API, endpointID, tokenID); err != nil {
+			resp.Diagnostics.Append(client.ErrorDiagnostic("Error deleting endpoint token", err))
+		}
+	}()
+
+	// Tokens are revoked on close unless explicitly kept.
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		revoke, err := json.Marshal(revokeToken{EndpointID: endpointID, TokenID: tokenID})
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating endpoint token", "Could not encode private data: "+err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, revokeTokenPrivateKey, revoke)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Hand the token to Terraform for this run only.
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the token when it was created with revoke_on_close.
+func (r *endpointTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	data, diags := req.Private.GetKey(ctx, revokeTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	var revoke revokeToken
+	if err := json.Unmarshal(data, &revoke); err != nil {
+		resp.Diagnostics.AddError("Error Deleting QuickNode Endpoint Token", "Could not decode private data: "+err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking QuickNode endpoint token", map[string]interface{}{
+		"endpoint_id": revoke.EndpointID,
+		"token_id":    revoke.TokenID,
+	})
+
+	if err := deleteEndpointToken(ctx, r.client.API, revoke.EndpointID, revoke.TokenID); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Deleting QuickNode Endpoint Token", err))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *endpointTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// findEndpointToken returns the token of the endpoint with the ID, or nil.
+func findEndpointToken(endpoint *api.SingleEndpoint, tokenID string) *api.EndpointToken {
+	if endpoint == nil || endpoint.Security.Tokens == nil {
+		return nil
+	}
+	for i, token := range *endpoint.Security.Tokens {
+		if token.Id != nil && *token.Id == tokenID {
+			return &(*endpoint.Security.Tokens)[i]
+		}
+	}
+	return nil
+}
+
+// deleteEndpointToken deletes the token of the endpoint. A token that is
+// already gone is not an error.
+func deleteEndpointToken(ctx context.Context, c *api.ClientWithResponses, endpointID, tokenID string) error {
+	deleteResp, err := c.DeleteTokenWithResponse(ctx, endpointID, tokenID)
+	if err != nil {
+		return fmt.Errorf("deleting token: %w", err)
+	}
+	if deleteResp.StatusCode() != http.StatusOK && deleteResp.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("deleting token: %w", client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccEphemeralProviderFactories adds the echo provider, which copies
+// ephemeral values into the state of a managed resource so tests can check them.
+var testAccEphemeralProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"quicknode": provider.TestAccProtoV6ProviderFactories["quicknode"],
+	"echo":      echoprovider.NewProviderServer(),
+}
+
+func TestAccEndpointTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccEphemeralProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// Create a token for the run, deleted once the run is over.
+			{
+				Config: testAccEndpointTokenEphemeralResourceConfig(``),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("http_url"), knownvalue.NotNull()),
+				},
+			},
+			// Create a token kept after the run.
+			{
+				Config: testAccEndpointTokenEphemeralResourceConfig(`revoke_on_close = false`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+			// Fetch an existing token.
+			{
+				Config: testAccEndpointTokenEphemeralResourceConfig(`token_id = quicknode_endpoint_token.test.id`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"echo.test", tfjsonpath.New("data").AtMapKey("token"),
+						"quicknode_endpoint_token.test", tfjsonpath.New("token"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			// A missing token is an error.
+			{
+				Config:      testAccEndpointTokenEphemeralResourceConfig(`token_id = "missing"`),
+				ExpectError: regexp.MustCompile("Endpoint Token Not Found"),
+			},
+		},
+	})
+}
+
+func testAccEndpointTokenEphemeralResourceConfig(arguments string) string {
+	return `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+
+  security_options = {
+    tokens = true
+  }
+}
+
+resource "quicknode_endpoint_token" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+}
+
+ephemeral "quicknode_endpoint_token" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+  ` + arguments + `
+}
+
+provider "echo" {
+  data = ephemeral.quicknode_endpoint_token.test
+}
+
+resource "echo" "test" {}
+`
+}
@@ -0,0 +1,51 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+func TestDeleteEndpointToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "deleted", status: http.StatusOK},
+		{name: "already gone", status: http.StatusNotFound},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.Method + " " + r.URL.Path
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"data":null,"error":null}`))
+			}))
+			defer server.Close()
+
+			c, err := api.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = deleteEndpointToken(context.Background(), c, "ep-1", "tok-1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+			if want := "DELETE /v0/endpoints/ep-1/security/tokens/tok-1"; gotPath != want {
+				t.Errorf("expected request %q, got %q", want, gotPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &endpointTokenResource{}
+	_ resource.ResourceWithConfigure   = &endpointTokenResource{}
+	_ resource.ResourceWithImportState = &endpointTokenResource{}
+)
+
+// NewEndpointTokenResource is a helper function to simplify the provider implementation.
+func NewEndpointTokenResource() resource.Resource {
+	return &endpointTokenResource{}
+}
+
+// endpointTokenResource is the resource implementation.
+type endpointTokenResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *endpointTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a new endpoint authentication token in the QuickNode API.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the created endpoint token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to create the token for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The authentication token value.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of the token. " +
+					"Use this to rotate the token.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *endpointTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.EndpointTokenResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new token.
+	token, err := createEndpointToken(ctx, r.client.API, plan.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error creating endpoint token", err))
+		return
+	}
+
+	plan.ID = types.StringValue(*token.Id)
+	plan.Token = types.StringValue(*token.Token)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// createEndpointToken creates a new authentication token for the endpoint.
+func createEndpointToken(ctx context.Context, c *api.ClientWithResponses, endpointID string) (api.EndpointToken, error) {
+	createResp, err := c.CreateAuthenticationTokenWithResponse(ctx, endpointID)
+	if err != nil {
+		return api.EndpointToken{}, fmt.Errorf("creating token: %w", err)
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		return api.EndpointToken{}, fmt.Errorf("creating token: %w", client.NewAPIError(createResp.HTTPResponse, createResp.Body))
+	}
+
+	// The CreateAuthenticationToken response doesn't have a typed JSON200 in the spec, so we parse the raw body.
+	var tokenResp struct {
+		Data api.EndpointToken `json:"data"`
+	}
+	if err := json.Unmarshal(createResp.Body, &tokenResp); err != nil {
+		return api.EndpointToken{}, fmt.Errorf("parsing token: %w", err)
+	}
+	if tokenResp.Data.Id == nil || tokenResp.Data.Token == nil {
+		return api.EndpointToken{}, errors.New("creating token: API returned an empty response")
+	}
+	return tokenResp.Data, nil
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *endpointTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.EndpointTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed endpoint value from QuickNode.
+	showResp, err := r.client.API.ShowEndpointWithResponse(ctx, state.EndpointID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Endpoint",
+			"Could not read QuickNode endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Endpoint",
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
+		return
+	}
+
+	endpoint := showResp.JSON200.Data
+
+	// Find specific token in tokens array.
+	found := false
+	if endpoint.Security.Tokens != nil {
+		for _, token := range *endpoint.Security.Tokens {
+			if token.Id != nil && *token.Id == state.ID.ValueString() {
+				found = true
+				if token.Token != nil {
+					state.Token = types.StringValue(*token.Token)
+				}
+				break
+			}
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *endpointTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Endpoint tokens cannot be updated in-place. This is a bug in the provider.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *endpointTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.EndpointTokenResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing token.
+	deleteResp, err := r.client.API.DeleteTokenWithResponse(ctx, state.EndpointID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Endpoint Token",
+			"Could not delete endpoint token, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if deleteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Endpoint Token",
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *endpointTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *endpointTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: endpoint_id/token_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
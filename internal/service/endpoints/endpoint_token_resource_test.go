@@ -0,0 +1,82 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEndpointTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccEndpointTokenResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_token.test", "id"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_token.test", "endpoint_id"),
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_token.test", "token"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:            "quicknode_endpoint_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keepers"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["quicknode_endpoint_token.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					return rs.Primary.Attributes["endpoint_id"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+			// Rotation testing.
+			{
+				Config: testAccEndpointTokenResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_endpoint_token.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_endpoint_token.test", "keepers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEndpointTokenResourceConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+
+  security_options = {
+    tokens          = true
+    referrers       = false
+    jwts            = false
+    ips             = false
+    domain_masks    = false
+    hsts            = false
+    cors            = true
+    request_filters = false
+  }
+}
+
+resource "quicknode_endpoint_token" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+
+  keepers = {
+    rotation = %q
+  }
+}
+`, rotation)
+}
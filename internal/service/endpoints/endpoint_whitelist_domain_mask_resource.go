@@ -99,10 +99,10 @@ func (r *endpointWhitelistDomainMaskResource) Create(ctx context.Context, req re
 		return
 	}
 	if createResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error creating endpoint whitelist domain mask",
-			fmt.Sprintf("API returned status %d: %s", createResp.StatusCode(), string(createResp.Body)),
-		)
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
 		return
 	}
 
@@ -150,20 +150,26 @@ func (r *endpointWhitelistDomainMaskResource) Read(ctx context.Context, req reso
 		)
 		return
 	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 
 	endpoint := showResp.JSON200.Data
 
 	// Find specific domain mask in domain masks array.
+	found := false
 	if endpoint.Security.DomainMasks != nil {
 		for _, dm := range *endpoint.Security.DomainMasks {
 			if dm.Id != nil && *dm.Id == state.ID.ValueString() {
+				found = true
 				if dm.Domain != nil {
 					state.DomainMask = types.StringValue(*dm.Domain)
 				}
@@ -172,6 +178,11 @@ func (r *endpointWhitelistDomainMaskResource) Read(ctx context.Context, req reso
 		}
 	}
 
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Set refreshed state.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -207,11 +218,14 @@ func (r *endpointWhitelistDomainMaskResource) Delete(ctx context.Context, req re
 		)
 		return
 	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
 	if deleteResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Deleting QuickNode Endpoint Whitelist Domain Mask",
-			fmt.Sprintf("API returned status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
-		)
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
 		return
 	}
 }
@@ -99,10 +99,10 @@ func (r *endpointWhitelistIPResource) Create(ctx context.Context, req resource.C
 		return
 	}
 	if createResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error creating endpoint whitelist IP",
-			fmt.Sprintf("API returned status %d: %s", createResp.StatusCode(), string(createResp.Body)),
-		)
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
 		return
 	}
 
@@ -150,20 +150,26 @@ func (r *endpointWhitelistIPResource) Read(ctx context.Context, req resource.Rea
 		)
 		return
 	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 
 	endpoint := showResp.JSON200.Data
 
 	// Find specific IP in IP array.
+	found := false
 	if endpoint.Security.Ips != nil {
 		for _, ip := range *endpoint.Security.Ips {
 			if ip.Id != nil && *ip.Id == state.ID.ValueString() {
+				found = true
 				if ip.Ip != nil {
 					state.IP = types.StringValue(*ip.Ip)
 				}
@@ -172,6 +178,11 @@ func (r *endpointWhitelistIPResource) Read(ctx context.Context, req resource.Rea
 		}
 	}
 
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Set refreshed state.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -207,11 +218,14 @@ func (r *endpointWhitelistIPResource) Delete(ctx context.Context, req resource.D
 		)
 		return
 	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
 	if deleteResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Deleting QuickNode Endpoint Whitelist IP",
-			fmt.Sprintf("API returned status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
-		)
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
 		return
 	}
 }
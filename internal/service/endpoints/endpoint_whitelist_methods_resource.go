@@ -12,8 +12,8 @@ import (
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -83,7 +83,7 @@ func (r *endpointWhitelistMethodsResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	methods := expandStringSet(ctx, plan.Method)
+	methods := typeutil.ExpandStringSet(ctx, plan.Method)
 
 	// Create new request filter.
 	createResp, err := r.client.API.CreateRequestFilterWithResponse(ctx, plan.EndpointID.ValueString(), api.CreateRequestFilterJSONRequestBody{
@@ -97,10 +97,10 @@ func (r *endpointWhitelistMethodsResource) Create(ctx context.Context, req resou
 		return
 	}
 	if createResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error creating endpoint whitelist method",
-			fmt.Sprintf("API returned status %d: %s", createResp.StatusCode(), string(createResp.Body)),
-		)
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
 		return
 	}
 
@@ -141,11 +141,15 @@ func (r *endpointWhitelistMethodsResource) Read(ctx context.Context, req resourc
 		)
 		return
 	}
+	if endpointGone(showResp) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if showResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Reading QuickNode Endpoint",
-			fmt.Sprintf("API returned status %d: %s", showResp.StatusCode(), string(showResp.Body)),
-		)
+			client.NewAPIError(showResp.HTTPResponse, showResp.Body),
+		))
 		return
 	}
 
@@ -158,7 +162,7 @@ func (r *endpointWhitelistMethodsResource) Read(ctx context.Context, req resourc
 			if rf.Id != nil && *rf.Id == state.ID.ValueString() {
 				found = true
 				if rf.Method != nil {
-					state.Method = flattenStringSet(*rf.Method)
+					state.Method = typeutil.FlattenStringSet(*rf.Method)
 				}
 				break
 			}
@@ -188,7 +192,7 @@ func (r *endpointWhitelistMethodsResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	methods := expandStringSet(ctx, plan.Method)
+	methods := typeutil.ExpandStringSet(ctx, plan.Method)
 
 	// Update request filter.
 	updateResp, err := r.client.API.UpdateRequestFilterWithResponse(ctx, plan.EndpointID.ValueString(), plan.ID.ValueString(), api.UpdateRequestFilterJSONRequestBody{
@@ -202,10 +206,10 @@ func (r *endpointWhitelistMethodsResource) Update(ctx context.Context, req resou
 		return
 	}
 	if updateResp.StatusCode() != http.StatusNoContent {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Updating QuickNode Endpoint Whitelist Method",
-			fmt.Sprintf("API returned status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
-		)
+			client.NewAPIError(updateResp.HTTPResponse, updateResp.Body),
+		))
 		return
 	}
 
@@ -236,11 +240,14 @@ func (r *endpointWhitelistMethodsResource) Delete(ctx context.Context, req resou
 		)
 		return
 	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
 	if deleteResp.StatusCode() != http.StatusNoContent {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
 			"Error Deleting QuickNode Endpoint Whitelist Method",
-			fmt.Sprintf("API returned status %d: %s", deleteResp.StatusCode(), string(deleteResp.Body)),
-		)
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
 		return
 	}
 }
@@ -279,20 +286,3 @@ func (r *endpointWhitelistMethodsResource) ImportState(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 }
-
-// expandStringSet converts a Terraform set of strings to a Go string slice.
-func expandStringSet(ctx context.Context, set types.Set) []string {
-	var result []string
-	set.ElementsAs(ctx, &result, false)
-	return result
-}
-
-// flattenStringSet converts a Go string slice to a Terraform set of strings.
-func flattenStringSet(values []string) types.Set {
-	elems := make([]attr.Value, len(values))
-	for i, v := range values {
-		elems[i] = types.StringValue(v)
-	}
-	s, _ := types.SetValue(types.StringType, elems)
-	return s
-}
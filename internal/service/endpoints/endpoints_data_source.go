@@ -12,8 +12,12 @@ import (
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
 	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -41,21 +45,52 @@ func (d *endpointsDataSource) Metadata(_ context.Context, req datasource.Metadat
 // Schema defines the schema for the data source.
 func (d *endpointsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Lists info for all available endpoints.",
+		Description: "Lists info for all available endpoints, optionally filtered by tag, chain, network and status.",
 		Attributes: map[string]schema.Attribute{
 			"limit": schema.Int64Attribute{
 				Description: "The number of endpoints to return.",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"offset": schema.Int64Attribute{
 				Description: "The offset to start from.",
 				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"all": schema.BoolAttribute{
+				Description: "Page through every endpoint of the account instead of returning a single page. Conflicts with `limit` and `offset`.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("limit"), path.MatchRoot("offset")),
+				},
+			},
+			"tag_labels": schema.SetAttribute{
+				Description: "Only return endpoints tagged with one of these labels, such as `env:prod`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tag_ids": schema.SetAttribute{
+				Description: "Only return endpoints tagged with one of these tag IDs.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"chain": schema.StringAttribute{
+				Description: "Only return endpoints of this chain, such as `eth`. Applied after `limit` and `offset`.",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description: "Only return endpoints of this network, such as `mainnet`. Applied after `limit` and `offset`.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return endpoints with this status, such as `active` or `paused`. Applied after `limit` and `offset`. " +
+					"Fetching the status takes one extra request per endpoint.",
+				Optional: true,
 			},
-			// TODO: Add tag support
-			// "tag_labels": schema.ListAttribute{
-			// 	Description: "The total number of endpoints.",
-			// 	Optional:    true,
-			// },
 			"endpoints": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -102,60 +137,76 @@ func (d *endpointsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	limit := int(config.Limit.ValueInt64())
-	offset := int(config.Offset.ValueInt64())
-	params := &api.ListEndpointsParams{
-		Limit:  &limit,
-		Offset: &offset,
+	params := api.ListEndpointsParams{}
+	if !config.Limit.IsNull() {
+		limit := int(config.Limit.ValueInt64())
+		params.Limit = &limit
+	}
+	if !config.Offset.IsNull() {
+		offset := int(config.Offset.ValueInt64())
+		params.Offset = &offset
+	}
+	if !config.TagLabels.IsNull() {
+		var tagLabels []string
+		resp.Diagnostics.Append(config.TagLabels.ElementsAs(ctx, &tagLabels, false)...)
+		params.TagLabels = &tagLabels
+	}
+	if !config.TagIDs.IsNull() {
+		var tagIDs []int64
+		resp.Diagnostics.Append(config.TagIDs.ElementsAs(ctx, &tagIDs, false)...)
+		ids := make([]int, 0, len(tagIDs))
+		for _, id := range tagIDs {
+			ids = append(ids, int(id))
+		}
+		params.TagIds = &ids
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Get refreshed endpoint value from QuickNode.
-	listResp, err := d.client.API.ListEndpointsWithResponse(ctx, params)
+	endpoints, err := listEndpoints(ctx, d.client.API, params, config.All.ValueBool())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Listing QuickNode Endpoints",
-			"Could not list QuickNode endpoints: "+err.Error(),
-		)
-		return
-	}
-	if listResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Error Listing QuickNode Endpoints",
-			fmt.Sprintf("API returned status %d: %s", listResp.StatusCode(), string(listResp.Body)),
-		)
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error Listing QuickNode Endpoints", err))
 		return
 	}
 
-	var state models.EndpointsDataSourceModel
-	state.Limit = config.Limit
-	state.Offset = config.Offset
-
-	if listResp.JSON200.Data != nil {
-		for _, endpoint := range *listResp.JSON200.Data {
-			label := ""
-			if endpoint.Label != nil {
-				label = *endpoint.Label
+	state := config
+	state.Endpoints = []models.EndpointsEndpointModel{}
+	for _, endpoint := range endpoints {
+		if !config.Chain.IsNull() && endpoint.Chain != config.Chain.ValueString() {
+			continue
+		}
+		if !config.Network.IsNull() && endpoint.Network != config.Network.ValueString() {
+			continue
+		}
+		if !config.Status.IsNull() {
+			status, err := endpointStatus(ctx, d.client.API, endpoint.Id)
+			if err != nil {
+				resp.Diagnostics.Append(client.ErrorDiagnostic("Error Listing QuickNode Endpoints", fmt.Errorf("reading status of endpoint %s: %w", endpoint.Id, err)))
+				return
 			}
-			wssURL := ""
-			if endpoint.WssUrl != nil {
-				wssURL = *endpoint.WssUrl
+			if status != config.Status.ValueString() {
+				continue
 			}
-			state.Endpoints = append(state.Endpoints, struct {
-				ID      types.String `tfsdk:"id"`
-				Label   types.String `tfsdk:"label"`
-				Chain   types.String `tfsdk:"chain"`
-				Network types.String `tfsdk:"network"`
-				HTTPURL types.String `tfsdk:"http_url"`
-				WSSURL  types.String `tfsdk:"wss_url"`
-			}{
-				ID:      types.StringValue(endpoint.Id),
-				Label:   types.StringValue(label),
-				Chain:   types.StringValue(endpoint.Chain),
-				Network: types.StringValue(endpoint.Network),
-				HTTPURL: types.StringValue(endpoint.HttpUrl),
-				WSSURL:  types.StringValue(wssURL),
-			})
 		}
+
+		label := ""
+		if endpoint.Label != nil {
+			label = *endpoint.Label
+		}
+		wssURL := ""
+		if endpoint.WssUrl != nil {
+			wssURL = *endpoint.WssUrl
+		}
+		state.Endpoints = append(state.Endpoints, models.EndpointsEndpointModel{
+			ID:      types.StringValue(endpoint.Id),
+			Label:   types.StringValue(label),
+			Chain:   types.StringValue(endpoint.Chain),
+			Network: types.StringValue(endpoint.Network),
+			HTTPURL: types.StringValue(endpoint.HttpUrl),
+			WSSURL:  types.StringValue(wssURL),
+		})
 	}
 
 	// Set refreshed state.
@@ -184,3 +235,63 @@ func (d *endpointsDataSource) Configure(_ context.Context, req datasource.Config
 
 	d.client = client
 }
+
+// endpointsPageSize is the number of endpoints requested per page when listing every endpoint.
+const endpointsPageSize = 100
+
+// listEndpoints returns a single page of endpoints, or with all set, pages
+// through every endpoint of the account using the filters of params.
+func listEndpoints(ctx context.Context, c *api.ClientWithResponses, params api.ListEndpointsParams, all bool) ([]api.Endpoint, error) {
+	if all {
+		limit, offset := endpointsPageSize, 0
+		params.Limit, params.Offset = &limit, &offset
+	}
+
+	endpoints := []api.Endpoint{}
+	seen := map[string]bool{}
+	for {
+		listResp, err := c.ListEndpointsWithResponse(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+		if listResp.StatusCode() != http.StatusOK {
+			return nil, client.NewAPIError(listResp.HTTPResponse, listResp.Body)
+		}
+
+		var page []api.Endpoint
+		if listResp.JSON200.Data != nil {
+			page = *listResp.JSON200.Data
+		}
+		added := 0
+		for _, endpoint := range page {
+			if !seen[endpoint.Id] {
+				seen[endpoint.Id] = true
+				endpoints = append(endpoints, endpoint)
+				added++
+			}
+		}
+
+		// Stop after an empty page, or a page without new endpoints in case
+		// the API ignores the offset. A short page isn't the end, as the API
+		// may cap the page size below the requested limit.
+		if !all || added == 0 {
+			return endpoints, nil
+		}
+		*params.Offset += len(page)
+	}
+}
+
+// endpointStatus returns the status of an endpoint, which the list API doesn't include.
+func endpointStatus(ctx context.Context, c *api.ClientWithResponses, endpointID string) (string, error) {
+	showResp, err := c.ShowEndpointWithResponse(ctx, endpointID)
+	if err != nil {
+		return "", err
+	}
+	if showResp.StatusCode() != http.StatusOK {
+		return "", client.NewAPIError(showResp.HTTPResponse, showResp.Body)
+	}
+	if showResp.JSON200.Data == nil || showResp.JSON200.Data.Status == nil {
+		return "", nil
+	}
+	return *showResp.JSON200.Data.Status, nil
+}
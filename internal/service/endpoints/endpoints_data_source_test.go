@@ -0,0 +1,52 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoints.all", "endpoints.#"),
+					resource.TestCheckResourceAttr("data.quicknode_endpoints.tagged", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("data.quicknode_endpoints.tagged", "endpoints.0.chain", "optimism"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEndpointsDataSourceConfig = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  tags    = ["tf-acc-test:endpoints"]
+}
+
+data "quicknode_endpoints" "all" {
+  all = true
+
+  depends_on = [quicknode_endpoint.test]
+}
+
+data "quicknode_endpoints" "tagged" {
+  all        = true
+  tag_labels = ["tf-acc-test:endpoints"]
+  network    = "optimism-sepolia"
+  status     = "active"
+
+  depends_on = [quicknode_endpoint.test]
+}
+`
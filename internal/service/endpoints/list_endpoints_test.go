@@ -0,0 +1,147 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+// newEndpointsServer serves total endpoints, honoring limit and offset, with
+// at most maxPage endpoints per page when it isn't zero.
+func newEndpointsServer(t *testing.T, total, maxPage int, requests *[]string) *api.ClientWithResponses {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit := total
+		if l := r.URL.Query().Get("limit"); l != "" {
+			limit, _ = strconv.Atoi(l)
+		}
+		if maxPage > 0 {
+			limit = min(limit, maxPage)
+		}
+
+		entries := []string{}
+		for i := offset; i < min(offset+limit, total); i++ {
+			entries = append(entries, fmt.Sprintf(`{"id":"ep-%d","chain":"eth","network":"mainnet","http_url":"https://example.com/%d"}`, i, i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"error":null}`, strings.Join(entries, ","))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return c
+}
+
+func TestListEndpoints_SinglePage(t *testing.T) {
+	var requests []string
+	c := newEndpointsServer(t, 30, 0, &requests)
+
+	endpoints, err := listEndpoints(context.Background(), c, api.ListEndpointsParams{}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(endpoints) != 30 {
+		t.Errorf("expected 30 endpoints, got %d", len(endpoints))
+	}
+	if len(requests) != 1 || requests[0] != "" {
+		t.Errorf("expected a single request without limit or offset, got %q", requests)
+	}
+}
+
+func TestListEndpoints_All(t *testing.T) {
+	var requests []string
+	c := newEndpointsServer(t, 250, 0, &requests)
+
+	tagLabels := []string{"env:prod"}
+	endpoints, err := listEndpoints(context.Background(), c, api.ListEndpointsParams{TagLabels: &tagLabels}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(endpoints) != 250 {
+		t.Fatalf("expected 250 endpoints, got %d", len(endpoints))
+	}
+	if endpoints[249].Id != "ep-249" {
+		t.Errorf("expected the last endpoint to be ep-249, got %s", endpoints[249].Id)
+	}
+	// The last page is empty.
+	if len(requests) != 4 {
+		t.Fatalf("expected 4 requests, got %d", len(requests))
+	}
+	for i, offset := range []int{0, 100, 200, 250} {
+		if query := requests[i]; !strings.Contains(query, fmt.Sprintf("offset=%d", offset)) || !strings.Contains(query, "tag_labels=env%3Aprod") {
+			t.Errorf("request %d: unexpected query %q", i, query)
+		}
+	}
+}
+
+func TestListEndpoints_AllWithCappedPageSize(t *testing.T) {
+	var requests []string
+	c := newEndpointsServer(t, 60, 25, &requests)
+
+	endpoints, err := listEndpoints(context.Background(), c, api.ListEndpointsParams{}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(endpoints) != 60 {
+		t.Fatalf("expected 60 endpoints, got %d", len(endpoints))
+	}
+	if len(requests) != 4 {
+		t.Fatalf("expected 4 requests, got %d", len(requests))
+	}
+	for i, offset := range []int{0, 25, 50, 60} {
+		if !strings.Contains(requests[i], fmt.Sprintf("offset=%d", offset)) {
+			t.Errorf("request %d: expected offset %d, got %q", i, offset, requests[i])
+		}
+	}
+}
+
+func TestListEndpoints_AllStopsWhenOffsetIsIgnored(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+
+		entries := []string{}
+		for i := range endpointsPageSize {
+			entries = append(entries, fmt.Sprintf(`{"id":"ep-%d","chain":"eth","network":"mainnet","http_url":""}`, i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"error":null}`, strings.Join(entries, ","))
+	}))
+	defer server.Close()
+
+	c, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	endpoints, err := listEndpoints(context.Background(), c, api.ListEndpointsParams{}, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(endpoints) != endpointsPageSize {
+		t.Errorf("expected %d endpoints, got %d", endpointsPageSize, len(endpoints))
+	}
+	if len(requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(requests))
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &accountMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountMetricsDataSource{}
+)
+
+// NewAccountMetricsDataSource is a helper function to simplify the provider implementation.
+func NewAccountMetricsDataSource() datasource.DataSource {
+	return &accountMetricsDataSource{}
+}
+
+// accountMetricsDataSource is the data source implementation.
+type accountMetricsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *accountMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_metrics"
+}
+
+// Schema defines the schema for the data source.
+func (d *accountMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a metric aggregated across all endpoints of the account from the QuickNode API as typed time series.",
+		Attributes: map[string]schema.Attribute{
+			"period": schema.StringAttribute{
+				Description: "The period to fetch the metric for. One of `hour`, `day`, `week` or `month`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricPeriods...),
+				},
+			},
+			"metric": schema.StringAttribute{
+				Description: "The metric to fetch, such as `method_calls_over_time` or `response_status_over_time`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricNames...),
+				},
+			},
+			"percentile": schema.StringAttribute{
+				Description: "The percentile to fetch for response time metrics such as `method_response_time_max`, for example `p95`.",
+				Optional:    true,
+			},
+			"series": seriesAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *accountMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.AccountMetricsDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading QuickNode account metric", map[string]interface{}{
+		"period":     state.Period.ValueString(),
+		"metric":     state.Metric.ValueString(),
+		"percentile": state.Percentile.ValueString(),
+	})
+
+	params := &api.FetchAccountMetricsParams{
+		Period: api.FetchAccountMetricsParamsPeriod(state.Period.ValueString()),
+		Metric: api.FetchAccountMetricsParamsMetric(state.Metric.ValueString()),
+	}
+	if !state.Percentile.IsNull() {
+		percentile := state.Percentile.ValueString()
+		params.Percentile = &percentile
+	}
+
+	metricResp, err := d.client.API.FetchAccountMetricsWithResponse(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Account Metric",
+			err.Error(),
+		)
+		return
+	}
+	if metricResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Unable to Read QuickNode Account Metric",
+			client.NewAPIError(metricResp.HTTPResponse, metricResp.Body),
+		))
+		return
+	}
+
+	state.Series = flattenMetricSeries(metricResp.JSON200.Data)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *accountMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,36 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountMetricsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountMetricsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_account_metrics.test", "percentile", "p95"),
+					resource.TestCheckResourceAttrSet("data.quicknode_account_metrics.test", "series.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAccountMetricsDataSourceConfig = `
+data "quicknode_account_metrics" "test" {
+  period     = "day"
+  metric     = "method_response_time_max"
+  percentile = "p95"
+}
+`
@@ -0,0 +1,133 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &endpointMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &endpointMetricsDataSource{}
+)
+
+// NewEndpointMetricsDataSource is a helper function to simplify the provider implementation.
+func NewEndpointMetricsDataSource() datasource.DataSource {
+	return &endpointMetricsDataSource{}
+}
+
+// endpointMetricsDataSource is the data source implementation.
+type endpointMetricsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *endpointMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_metrics"
+}
+
+// Schema defines the schema for the data source.
+func (d *endpointMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a metric of an endpoint from the QuickNode API as typed time series.",
+		Attributes: map[string]schema.Attribute{
+			"endpoint_id": schema.StringAttribute{
+				Description: "The ID of the endpoint to fetch the metric for.",
+				Required:    true,
+			},
+			"period": schema.StringAttribute{
+				Description: "The period to fetch the metric for. One of `hour`, `day`, `week` or `month`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricPeriods...),
+				},
+			},
+			"metric": schema.StringAttribute{
+				Description: "The metric to fetch, such as `method_calls_over_time` or `response_status_over_time`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricNames...),
+				},
+			},
+			"series": seriesAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *endpointMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.EndpointMetricsDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading QuickNode endpoint metric", map[string]interface{}{
+		"endpoint_id": state.EndpointID.ValueString(),
+		"period":      state.Period.ValueString(),
+		"metric":      state.Metric.ValueString(),
+	})
+
+	metricResp, err := d.client.API.FetchEndpointMetricWithResponse(ctx, state.EndpointID.ValueString(), &api.FetchEndpointMetricParams{
+		Period: api.FetchEndpointMetricParamsPeriod(state.Period.ValueString()),
+		Metric: api.FetchEndpointMetricParamsMetric(state.Metric.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Endpoint Metric",
+			"Could not read metric of QuickNode endpoint ID "+state.EndpointID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if metricResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Unable to Read QuickNode Endpoint Metric",
+			client.NewAPIError(metricResp.HTTPResponse, metricResp.Body),
+		))
+		return
+	}
+
+	state.Series = flattenMetricSeries(metricResp.JSON200.Data)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *endpointMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointMetricsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointMetricsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.quicknode_endpoint_metrics.test", "metric", "response_status_over_time"),
+					resource.TestCheckResourceAttrSet("data.quicknode_endpoint_metrics.test", "series.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEndpointMetricsDataSourceConfig = `
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "tf-acc-test"
+}
+
+data "quicknode_endpoint_metrics" "test" {
+  endpoint_id = quicknode_endpoint.test.id
+  period      = "hour"
+  metric      = "response_status_over_time"
+}
+`
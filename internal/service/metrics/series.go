@@ -0,0 +1,157 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"math"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// metricPeriods are the periods accepted by the metrics APIs.
+var metricPeriods = []string{
+	string(api.FetchEndpointMetricParamsPeriodHour),
+	string(api.FetchEndpointMetricParamsPeriodDay),
+	string(api.FetchEndpointMetricParamsPeriodWeek),
+	string(api.FetchEndpointMetricParamsPeriodMonth),
+}
+
+// metricNames are the metrics accepted by the metrics APIs.
+var metricNames = []string{
+	string(api.FetchEndpointMetricParamsMetricMethodCallsOverTime),
+	string(api.FetchEndpointMetricParamsMetricResponseStatusOverTime),
+	string(api.FetchEndpointMetricParamsMetricMethodCallBreakdown),
+	string(api.FetchEndpointMetricParamsMetricResponseStatusBreakdown),
+	string(api.FetchEndpointMetricParamsMetricMethodResponseTimeMax),
+	string(api.FetchEndpointMetricParamsMetricRequestErrorsOverTime),
+	string(api.FetchEndpointMetricParamsMetricTotalRequestErrorsOverTime),
+}
+
+// millisecondThreshold separates timestamps in seconds from timestamps in
+// milliseconds. Timestamps in seconds don't reach it until the year 33658.
+const millisecondThreshold = 1_000_000_000_000
+
+// seriesAttribute returns the schema attribute of the decoded metric series.
+func seriesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "The decoded metric series, one per tag.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"tag": schema.StringAttribute{
+					Description: "The tag of the series, such as the RPC method or the response status.",
+					Computed:    true,
+				},
+				"points": schema.ListNestedAttribute{
+					Description: "The data points of the series, in the order returned by the API.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"timestamp": schema.Int64Attribute{
+								Description: "The timestamp of the data point as returned by the API. Not set for breakdown metrics.",
+								Computed:    true,
+							},
+							"time": schema.StringAttribute{
+								Description: "The timestamp of the data point as an RFC3339 timestamp. Not set for breakdown metrics.",
+								Computed:    true,
+							},
+							"value": schema.Int64Attribute{
+								Description: "The value of the data point.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+				"min": schema.Int64Attribute{
+					Description: "The smallest value of the series. Not set when the series has no data points.",
+					Computed:    true,
+				},
+				"max": schema.Int64Attribute{
+					Description: "The largest value of the series. Not set when the series has no data points.",
+					Computed:    true,
+				},
+				"avg": schema.Float64Attribute{
+					Description: "The average value of the series. Not set when the series has no data points.",
+					Computed:    true,
+				},
+				"last": schema.Int64Attribute{
+					Description: "The value of the last data point of the series. Not set when the series has no data points.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// flattenMetricSeries decodes the raw [timestamp, value] pairs of each metric
+// into typed series and summarizes their values. Breakdown metrics only carry
+// a single value per point.
+func flattenMetricSeries(metrics []api.EndpointMetric) []models.MetricSeriesModel {
+	series := []models.MetricSeriesModel{}
+	for _, metric := range metrics {
+		s := models.MetricSeriesModel{
+			Tag:    types.StringPointerValue(metric.Tag),
+			Points: []models.MetricPointModel{},
+		}
+
+		if metric.Data != nil {
+			for _, raw := range *metric.Data {
+				point := models.MetricPointModel{
+					Timestamp: types.Int64Null(),
+					Time:      types.StringNull(),
+					Value:     types.Int64Null(),
+				}
+				switch len(raw) {
+				case 0:
+					continue
+				case 1:
+					point.Value = types.Int64Value(int64(raw[0]))
+				default:
+					point.Timestamp = types.Int64Value(int64(raw[0]))
+					point.Time = types.StringValue(metricTime(int64(raw[0])).Format(time.RFC3339))
+					point.Value = types.Int64Value(int64(raw[1]))
+				}
+				s.Points = append(s.Points, point)
+			}
+		}
+
+		summarizeMetricSeries(&s)
+		series = append(series, s)
+	}
+	return series
+}
+
+// summarizeMetricSeries sets the min, max, avg and last values of the series.
+func summarizeMetricSeries(s *models.MetricSeriesModel) {
+	s.Min, s.Max, s.Avg, s.Last = types.Int64Null(), types.Int64Null(), types.Float64Null(), types.Int64Null()
+	if len(s.Points) == 0 {
+		return
+	}
+
+	minValue, maxValue, sum := int64(math.MaxInt64), int64(math.MinInt64), 0.0
+	for _, point := range s.Points {
+		v := point.Value.ValueInt64()
+		minValue = min(minValue, v)
+		maxValue = max(maxValue, v)
+		sum += float64(v)
+	}
+
+	s.Min = types.Int64Value(minValue)
+	s.Max = types.Int64Value(maxValue)
+	s.Avg = types.Float64Value(sum / float64(len(s.Points)))
+	s.Last = s.Points[len(s.Points)-1].Value
+}
+
+// metricTime converts a metric timestamp in seconds or milliseconds to a UTC time.
+func metricTime(timestamp int64) time.Time {
+	if timestamp >= millisecondThreshold {
+		return time.UnixMilli(timestamp).UTC()
+	}
+	return time.Unix(timestamp, 0).UTC()
+}
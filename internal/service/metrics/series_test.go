@@ -0,0 +1,97 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+)
+
+func TestFlattenMetricSeries_Pairs(t *testing.T) {
+	tag := "eth_call"
+	data := [][]int{{1717200000, 10}, {1717203600, 25}}
+
+	series := flattenMetricSeries([]api.EndpointMetric{{Tag: &tag, Data: &data}})
+	if len(series) != 1 {
+		t.Fatalf("expected 1 series, got %d", len(series))
+	}
+	if got := series[0].Tag.ValueString(); got != tag {
+		t.Errorf("expected tag %q, got %q", tag, got)
+	}
+	if len(series[0].Points) != 2 {
+		t.Fatalf("expected 2 points, got %d", len(series[0].Points))
+	}
+
+	point := series[0].Points[1]
+	if got := point.Timestamp.ValueInt64(); got != 1717203600 {
+		t.Errorf("expected timestamp 1717203600, got %d", got)
+	}
+	if got := point.Time.ValueString(); got != "2024-06-01T01:00:00Z" {
+		t.Errorf("expected time 2024-06-01T01:00:00Z, got %s", got)
+	}
+	if got := point.Value.ValueInt64(); got != 25 {
+		t.Errorf("expected value 25, got %d", got)
+	}
+}
+
+func TestFlattenMetricSeries_Milliseconds(t *testing.T) {
+	data := [][]int{{1717200000000, 1}}
+
+	series := flattenMetricSeries([]api.EndpointMetric{{Data: &data}})
+	if got := series[0].Points[0].Time.ValueString(); got != "2024-06-01T00:00:00Z" {
+		t.Errorf("expected time 2024-06-01T00:00:00Z, got %s", got)
+	}
+	if !series[0].Tag.IsNull() {
+		t.Errorf("expected null tag, got %s", series[0].Tag)
+	}
+}
+
+func TestFlattenMetricSeries_SingleValues(t *testing.T) {
+	tag := "200"
+	data := [][]int{{42}, {}}
+
+	series := flattenMetricSeries([]api.EndpointMetric{{Tag: &tag, Data: &data}})
+	if len(series[0].Points) != 1 {
+		t.Fatalf("expected 1 point, got %d", len(series[0].Points))
+	}
+
+	point := series[0].Points[0]
+	if !point.Timestamp.IsNull() || !point.Time.IsNull() {
+		t.Errorf("expected null timestamp, got %s / %s", point.Timestamp, point.Time)
+	}
+	if got := point.Value.ValueInt64(); got != 42 {
+		t.Errorf("expected value 42, got %d", got)
+	}
+}
+
+func TestFlattenMetricSeries_Summaries(t *testing.T) {
+	tag := "p95"
+	data := [][]int{{1717200000, 120}, {1717203600, 80}, {1717207200, 100}}
+	empty := [][]int{}
+
+	series := flattenMetricSeries([]api.EndpointMetric{{Tag: &tag, Data: &data}, {Data: &empty}})
+	if len(series) != 2 {
+		t.Fatalf("expected 2 series, got %d", len(series))
+	}
+
+	s := series[0]
+	if got := s.Min.ValueInt64(); got != 80 {
+		t.Errorf("expected min 80, got %d", got)
+	}
+	if got := s.Max.ValueInt64(); got != 120 {
+		t.Errorf("expected max 120, got %d", got)
+	}
+	if got := s.Avg.ValueFloat64(); got != 100 {
+		t.Errorf("expected avg 100, got %f", got)
+	}
+	if got := s.Last.ValueInt64(); got != 100 {
+		t.Errorf("expected last 100, got %d", got)
+	}
+
+	s = series[1]
+	if !s.Min.IsNull() || !s.Max.IsNull() || !s.Avg.IsNull() || !s.Last.IsNull() {
+		t.Errorf("expected null summaries for an empty series, got min=%s max=%s avg=%s last=%s", s.Min, s.Max, s.Avg, s.Last)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teamDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamDataSource{}
+)
+
+// NewTeamDataSource is a helper function to simplify the provider implementation.
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+// teamDataSource is the data source implementation.
+type teamDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *teamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the data source.
+func (d *teamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns info for a specific team, including its members and pending invites.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the team.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the team.",
+				Computed:    true,
+			},
+			"default_role": schema.StringAttribute{
+				Description: "The role given to members of the team by default.",
+				Computed:    true,
+			},
+			"members_count": schema.Int64Attribute{
+				Description: "The number of members in the team.",
+				Computed:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users that are members of the team.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamUserAttributes(),
+				},
+			},
+			"pending_invites": schema.ListNestedAttribute{
+				Description: "The users that have been invited to the team but have not accepted yet.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamUserAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config models.TeamDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", config.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	// Get refreshed team value from QuickNode.
+	getResp, err := d.client.API.GetTeamWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Team",
+			"Could not read QuickNode team ID "+config.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if getResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Team",
+			client.NewAPIError(getResp.HTTPResponse, getResp.Body),
+		))
+		return
+	}
+
+	team := getResp.JSON200.Data
+	state := models.TeamDataSourceModel{
+		ID:             config.ID,
+		Name:           types.StringPointerValue(team.Name),
+		DefaultRole:    types.StringPointerValue(team.DefaultRole),
+		MembersCount:   typeutil.IntPointerToInt64(team.MembersCount),
+		Users:          []models.TeamUserModel{},
+		PendingInvites: []models.TeamUserModel{},
+	}
+	if team.Users != nil {
+		for _, user := range *team.Users {
+			state.Users = append(state.Users, models.TeamUserModel{
+				ID:                 intPointerToString(user.Id),
+				Email:              types.StringPointerValue(user.Email),
+				FullName:           types.StringPointerValue(user.FullName),
+				Role:               types.StringPointerValue(user.Role),
+				Status:             types.StringPointerValue(user.Status),
+				AccountPrimaryUser: types.BoolPointerValue(user.AccountPrimaryUser),
+				CreatedAt:          types.StringPointerValue(user.CreatedAt),
+			})
+		}
+	}
+	if team.PendingInvites != nil {
+		for _, invite := range *team.PendingInvites {
+			state.PendingInvites = append(state.PendingInvites, models.TeamUserModel{
+				ID:                 intPointerToString(invite.Id),
+				Email:              types.StringPointerValue(invite.Email),
+				FullName:           types.StringPointerValue(invite.FullName),
+				Role:               types.StringPointerValue(invite.Role),
+				Status:             types.StringPointerValue(invite.Status),
+				AccountPrimaryUser: types.BoolPointerValue(invite.AccountPrimaryUser),
+				CreatedAt:          types.StringPointerValue(invite.CreatedAt),
+			})
+		}
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// teamUserAttributes returns the schema attributes of a team user.
+func teamUserAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "A unique identifier for the user.",
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "The email address of the user.",
+			Computed:    true,
+		},
+		"full_name": schema.StringAttribute{
+			Description: "The full name of the user.",
+			Computed:    true,
+		},
+		"role": schema.StringAttribute{
+			Description: "The role of the user in the team.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the user, for example `pending` or `accepted`.",
+			Computed:    true,
+		},
+		"account_primary_user": schema.BoolAttribute{
+			Description: "Whether the user is the primary user of the account.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "When the user was added to the team.",
+			Computed:    true,
+		},
+	}
+}
+
+// intPointerToString converts an optional API integer ID to a Terraform String value.
+func intPointerToString(v *int) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.Itoa(*v))
+}
@@ -0,0 +1,44 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamDataSourcesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.quicknode_team.test", "id", "quicknode_team.test", "id"),
+					resource.TestCheckResourceAttr("data.quicknode_team.test", "name", "tf-acc-test-ds"),
+					resource.TestCheckResourceAttr("data.quicknode_team.test", "users.#", "0"),
+					resource.TestCheckResourceAttrSet("data.quicknode_teams.test", "teams.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamDataSourcesConfig = `
+resource "quicknode_team" "test" {
+  name = "tf-acc-test-ds"
+}
+
+data "quicknode_team" "test" {
+  id = quicknode_team.test.id
+}
+
+data "quicknode_teams" "test" {
+  depends_on = [quicknode_team.test]
+}
+`
@@ -0,0 +1,336 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamEndpointsResource{}
+	_ resource.ResourceWithConfigure   = &teamEndpointsResource{}
+	_ resource.ResourceWithImportState = &teamEndpointsResource{}
+)
+
+// errTeamNotFound is returned when the team no longer exists.
+var errTeamNotFound = errors.New("team not found")
+
+// NewTeamEndpointsResource is a helper function to simplify the provider implementation.
+func NewTeamEndpointsResource() resource.Resource {
+	return &teamEndpointsResource{}
+}
+
+// teamEndpointsResource is the resource implementation.
+type teamEndpointsResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *teamEndpointsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_endpoints"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamEndpointsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the full set of endpoints assigned to a team in the QuickNode API. " +
+			"Every apply assigns exactly `endpoint_ids` to the team and unassigns any other endpoint.\n\n" +
+			"The QuickNode API lists the endpoints assigned to a team together with the endpoints that are not assigned to any team, " +
+			"so it can't tell them apart. Endpoints that are deleted or assigned to another team outside of Terraform are detected, " +
+			"but endpoints that are unassigned outside of Terraform are not. For the same reason, an import must list the assigned endpoints.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the team. Same as `team_id`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team to assign the endpoints to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_ids": schema.SetAttribute{
+				Description: "The set of endpoint IDs assigned to the team. An empty set unassigns all endpoints.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"accessible_endpoint_ids": schema.SetAttribute{
+				Description: "The set of endpoint IDs the team can access. This includes the assigned endpoints " +
+					"as well as endpoints that are not assigned to any team and are accessible to everyone.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// updateTeamEndpoints replaces the endpoints assigned to the team.
+func (r *teamEndpointsResource) updateTeamEndpoints(ctx context.Context, teamID int, endpointIDs []string) error {
+	if endpointIDs == nil {
+		endpointIDs = []string{}
+	}
+
+	updateResp, err := r.client.API.UpdateTeamEndpointsWithResponse(ctx, teamID, api.UpdateTeamEndpointsJSONRequestBody{
+		EndpointIds: endpointIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("updating team endpoints: %w", err)
+	}
+	if updateResp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("updating team endpoints: %w", client.NewAPIError(updateResp.HTTPResponse, updateResp.Body))
+	}
+	return nil
+}
+
+// listTeamEndpoints returns the IDs of all endpoints the team can access.
+func (r *teamEndpointsResource) listTeamEndpoints(ctx context.Context, teamID int) ([]string, error) {
+	listResp, err := r.client.API.ListTeamEndpointsWithResponse(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("listing team endpoints: %w", err)
+	}
+	if listResp.StatusCode() == http.StatusNotFound {
+		return nil, errTeamNotFound
+	}
+	if listResp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("listing team endpoints: %w", client.NewAPIError(listResp.HTTPResponse, listResp.Body))
+	}
+
+	ids := []string{}
+	for _, endpoint := range listResp.JSON200.Data {
+		if endpoint.Id != nil {
+			ids = append(ids, strconv.Itoa(*endpoint.Id))
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// assignedEndpoints returns the endpoints of assigned that the team can still
+// access. The API also lists endpoints that are not assigned to any team, so
+// this drops endpoints that were deleted or assigned to another team, but
+// can't detect endpoints that were unassigned from the team.
+func assignedEndpoints(assigned, accessible []string) []string {
+	accessibleSet := make(map[string]bool, len(accessible))
+	for _, id := range accessible {
+		accessibleSet[id] = true
+	}
+
+	result := []string{}
+	for _, id := range assigned {
+		if accessibleSet[id] {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Create a new resource.
+func (r *teamEndpointsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.TeamEndpointsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	if err := r.updateTeamEndpoints(ctx, teamID, typeutil.ExpandStringSet(ctx, plan.EndpointIDs)); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error assigning team endpoints", err))
+		return
+	}
+
+	accessible, err := r.listTeamEndpoints(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error reading team endpoints", err))
+		return
+	}
+
+	plan.ID = plan.TeamID
+	plan.AccessibleEndpointIDs = typeutil.FlattenStringSet(accessible)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamEndpointsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.TeamEndpointsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	accessible, err := r.listTeamEndpoints(ctx, teamID)
+	if errors.Is(err, errTeamNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error reading team endpoints", err))
+		return
+	}
+
+	state.ID = state.TeamID
+	state.EndpointIDs = typeutil.FlattenStringSet(assignedEndpoints(typeutil.ExpandStringSet(ctx, state.EndpointIDs), accessible))
+	state.AccessibleEndpointIDs = typeutil.FlattenStringSet(accessible)
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamEndpointsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan.
+	var plan models.TeamEndpointsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	if err := r.updateTeamEndpoints(ctx, teamID, typeutil.ExpandStringSet(ctx, plan.EndpointIDs)); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error assigning team endpoints", err))
+		return
+	}
+
+	accessible, err := r.listTeamEndpoints(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error reading team endpoints", err))
+		return
+	}
+
+	plan.ID = plan.TeamID
+	plan.AccessibleEndpointIDs = typeutil.FlattenStringSet(accessible)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamEndpointsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.TeamEndpointsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	// Unassign all endpoints from the team.
+	if err := r.updateTeamEndpoints(ctx, teamID, []string{}); err != nil {
+		resp.Diagnostics.Append(client.ErrorDiagnostic("Error unassigning team endpoints", err))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamEndpointsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *teamEndpointsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The API can't tell the endpoints assigned to the team from those that
+	// are not assigned to any team, so the import ID lists the assigned ones.
+	teamID, endpointIDs, ok := strings.Cut(req.ID, "/")
+	if !ok || teamID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: team_id/endpoint_id,endpoint_id,... got: %s. "+
+				"The QuickNode API lists the endpoints assigned to a team together with the endpoints that are not assigned to any team, "+
+				"so the assigned endpoints must be listed explicitly. Use team_id/ for a team without endpoints.", req.ID),
+		)
+		return
+	}
+
+	ids := []string{}
+	for _, id := range strings.Split(endpointIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint_ids"), ids)...)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTeamEndpointsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccTeamEndpointsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("quicknode_team_endpoints.test", "id", "quicknode_team.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_team_endpoints.test", "endpoint_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("quicknode_team_endpoints.test", "endpoint_ids.*", "quicknode_endpoint.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("quicknode_team_endpoints.test", "accessible_endpoint_ids.*", "quicknode_endpoint.test", "id"),
+				),
+			},
+			// ImportState testing. The import ID lists the assigned endpoints.
+			{
+				ResourceName:      "quicknode_team_endpoints.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["quicknode_endpoint.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					team := s.RootModule().Resources["quicknode_team.test"]
+					return team.Primary.ID + "/" + rs.Primary.ID, nil
+				},
+			},
+			// A team ID alone can't be imported.
+			{
+				ResourceName:  "quicknode_team_endpoints.test",
+				ImportState:   true,
+				ImportStateId: "1",
+				ExpectError:   regexp.MustCompile("Invalid Import ID"),
+			},
+			// Unassign testing.
+			{
+				Config: testAccTeamEndpointsResourceConfigEmpty,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_team_endpoints.test", "endpoint_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamEndpointsResourceConfig = `
+resource "quicknode_team" "test" {
+  name = "tf-acc-test-endpoints"
+}
+
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "tf-acc-test"
+}
+
+resource "quicknode_team_endpoints" "test" {
+  team_id      = quicknode_team.test.id
+  endpoint_ids = [quicknode_endpoint.test.id]
+}
+`
+
+const testAccTeamEndpointsResourceConfigEmpty = `
+resource "quicknode_team" "test" {
+  name = "tf-acc-test-endpoints"
+}
+
+resource "quicknode_endpoint" "test" {
+  chain   = "optimism"
+  network = "optimism-sepolia"
+  label   = "tf-acc-test"
+}
+
+resource "quicknode_team_endpoints" "test" {
+  team_id      = quicknode_team.test.id
+  endpoint_ids = []
+}
+`
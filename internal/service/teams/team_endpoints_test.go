@@ -0,0 +1,70 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+)
+
+// newTeamEndpointsResource returns a resource whose ListTeamEndpoints calls
+// get the status and body.
+func newTeamEndpointsResource(t *testing.T, status int, body string) *teamEndpointsResource {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := api.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return &teamEndpointsResource{client: &client.Client{API: c}}
+}
+
+func TestTeamEndpoints_MixedAssignedAndUnassigned(t *testing.T) {
+	// Endpoint 1 is assigned to the team, 3 and 4 are not assigned to any
+	// team, and 2 was assigned to another team outside of Terraform.
+	r := newTeamEndpointsResource(t, http.StatusOK, `{"data":[
+		{"id":4,"subdomain":"d","chain":"eth","network":"mainnet"},
+		{"id":1,"subdomain":"a","chain":"eth","network":"mainnet"},
+		{"id":3,"subdomain":"c","chain":"eth","network":"mainnet"}
+	],"error":null}`)
+
+	accessible, err := r.listTeamEndpoints(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"1", "3", "4"}; !reflect.DeepEqual(accessible, want) {
+		t.Errorf("expected accessible endpoints %v, got %v", want, accessible)
+	}
+
+	// Unassigned endpoints are never adopted, and endpoints the team can no
+	// longer access are dropped.
+	if got, want := assignedEndpoints([]string{"2", "1"}, accessible), []string{"1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected assigned endpoints %v, got %v", want, got)
+	}
+	if got := assignedEndpoints(nil, accessible); len(got) != 0 {
+		t.Errorf("expected no assigned endpoints, got %v", got)
+	}
+}
+
+func TestTeamEndpoints_TeamNotFound(t *testing.T) {
+	r := newTeamEndpointsResource(t, http.StatusNotFound, `{"data":null,"error":"team not found"}`)
+
+	if _, err := r.listTeamEndpoints(context.Background(), 7); !errors.Is(err, errTeamNotFound) {
+		t.Errorf("expected errTeamNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamMemberResource{}
+	_ resource.ResourceWithConfigure   = &teamMemberResource{}
+	_ resource.ResourceWithImportState = &teamMemberResource{}
+)
+
+// NewTeamMemberResource is a helper function to simplify the provider implementation.
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// teamMemberResource is the resource implementation.
+type teamMemberResource struct {
+	client *client.Client
+}
+
+// teamMemberResponse is a member or pending invite of a GetTeam response.
+type teamMemberResponse struct {
+	ID       *int
+	Email    *string
+	FullName *string
+	Role     *string
+	Status   *string
+}
+
+// Metadata returns the resource type name.
+func (r *teamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites a user to a team in the QuickNode API, or adds an existing user of the account to the team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the invited user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team to invite the user to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address of the user to invite.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Description: "The full name of the user. Required when inviting a user that is not yet part of the account.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user. One of `admin`, `viewer` or `billing`. Required when inviting a user that is not yet part of the account.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.Admin),
+						string(api.Viewer),
+						string(api.Billing),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the invitation, for example `pending` or `accepted`.",
+				Computed:    true,
+			},
+			"resend_trigger": schema.StringAttribute{
+				Description: "An arbitrary value that resends the invitation email when changed while the invitation is still pending.",
+				Optional:    true,
+			},
+			"destroy_user": schema.BoolAttribute{
+				Description: "Whether to delete the user from the account, instead of only removing it from the team, when this resource is destroyed. (default: false)",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.TeamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	body := api.InviteTeamMemberJSONRequestBody{
+		Email: plan.Email.ValueString(),
+	}
+	if !plan.FullName.IsNull() && !plan.FullName.IsUnknown() {
+		fullName := plan.FullName.ValueString()
+		body.FullName = &fullName
+	}
+	if !plan.Role.IsNull() && !plan.Role.IsUnknown() {
+		role := api.InviteTeamMemberJSONBodyRole(plan.Role.ValueString())
+		body.Role = &role
+	}
+
+	// Invite the team member.
+	inviteResp, err := r.client.API.InviteTeamMemberWithResponse(ctx, teamID, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error inviting team member",
+			"Could not invite team member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if inviteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error inviting team member",
+			client.NewAPIError(inviteResp.HTTPResponse, inviteResp.Body),
+		))
+		return
+	}
+
+	member := inviteResp.JSON200.Data
+	if member.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error inviting team member",
+			"API returned an empty response",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(*member.Id))
+	// An existing user of the account keeps its own name and role, so only
+	// fill in the values that weren't configured.
+	if plan.FullName.IsUnknown() {
+		plan.FullName = types.StringPointerValue(member.FullName)
+	}
+	if plan.Role.IsUnknown() {
+		plan.Role = types.StringPointerValue(member.Role)
+	}
+	plan.Status = types.StringPointerValue(member.Status)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.TeamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	// Get refreshed team value from QuickNode.
+	getResp, err := r.client.API.GetTeamWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Team",
+			"Could not read QuickNode team ID "+state.TeamID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if getResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if getResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Team",
+			client.NewAPIError(getResp.HTTPResponse, getResp.Body),
+		))
+		return
+	}
+
+	// Find the specific member in the team's users and pending invites.
+	team := getResp.JSON200.Data
+	var members []teamMemberResponse
+	if team.Users != nil {
+		for _, user := range *team.Users {
+			members = append(members, teamMemberResponse{ID: user.Id, Email: user.Email, FullName: user.FullName, Role: user.Role, Status: user.Status})
+		}
+	}
+	if team.PendingInvites != nil {
+		for _, invite := range *team.PendingInvites {
+			members = append(members, teamMemberResponse{ID: invite.Id, Email: invite.Email, FullName: invite.FullName, Role: invite.Role, Status: invite.Status})
+		}
+	}
+	var member *teamMemberResponse
+	for i := range members {
+		if members[i].ID != nil && strconv.Itoa(*members[i].ID) == state.ID.ValueString() {
+			member = &members[i]
+			break
+		}
+	}
+
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if member.Email != nil && !strings.EqualFold(*member.Email, state.Email.ValueString()) {
+		state.Email = types.StringValue(*member.Email)
+	}
+	// Keep the configured name, which may differ from that of an existing
+	// user of the account, and only fill it in after an import.
+	if state.FullName.IsNull() && member.FullName != nil {
+		state.FullName = types.StringValue(*member.FullName)
+	}
+	if member.Role != nil {
+		state.Role = types.StringValue(*member.Role)
+	}
+	state.Status = types.StringPointerValue(member.Status)
+	if state.DestroyUser.IsNull() {
+		state.DestroyUser = types.BoolValue(false)
+	}
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state.
+	var plan, state models.TeamMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only resend_trigger and destroy_user can change in-place, and
+	// destroy_user only takes effect on delete.
+	if !plan.ResendTrigger.Equal(state.ResendTrigger) && state.Status.ValueString() == "pending" {
+		teamID, err := parseNumericID("team", state.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+			return
+		}
+		userID, err := parseNumericID("user", state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid User ID", err.Error())
+			return
+		}
+
+		resendResp, err := r.client.API.ResendTeamInviteWithResponse(ctx, teamID, userID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resending team invite",
+				"Could not resend team invite, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if resendResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
+				"Error resending team invite",
+				client.NewAPIError(resendResp.HTTPResponse, resendResp.Body),
+			))
+			return
+		}
+	}
+
+	plan.Status = state.Status
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.TeamMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+	userID, err := parseNumericID("user", state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid User ID", err.Error())
+		return
+	}
+
+	// Remove the member from the team, optionally deleting the user from the account.
+	destroyUser := state.DestroyUser.ValueBool()
+	deleteResp, err := r.client.API.RemoveTeamMemberWithResponse(ctx, teamID, userID, api.RemoveTeamMemberJSONRequestBody{
+		DestroyUser: &destroyUser,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Team Member",
+			"Could not remove team member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if deleteResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if deleteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Team Member",
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: team_id/user_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
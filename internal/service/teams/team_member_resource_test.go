@@ -0,0 +1,73 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTeamMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccTeamMemberResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_team_member.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_team_member.test", "email", "tf-acc-test@example.com"),
+					resource.TestCheckResourceAttr("quicknode_team_member.test", "role", "viewer"),
+					resource.TestCheckResourceAttr("quicknode_team_member.test", "status", "pending"),
+					resource.TestCheckResourceAttr("quicknode_team_member.test", "destroy_user", "true"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:            "quicknode_team_member.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resend_trigger", "destroy_user"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["quicknode_team_member.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found")
+					}
+					return rs.Primary.Attributes["team_id"] + "/" + rs.Primary.Attributes["id"], nil
+				},
+			},
+			// Resend invite testing.
+			{
+				Config: testAccTeamMemberResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("quicknode_team_member.test", "resend_trigger", "2"),
+					resource.TestCheckResourceAttr("quicknode_team_member.test", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamMemberResourceConfig(resendTrigger string) string {
+	return fmt.Sprintf(`
+resource "quicknode_team" "test" {
+  name = "tf-acc-test-members"
+}
+
+resource "quicknode_team_member" "test" {
+  team_id        = quicknode_team.test.id
+  email          = "tf-acc-test@example.com"
+  full_name      = "Terraform Acceptance Test"
+  role           = "viewer"
+  resend_trigger = %q
+  destroy_user   = true
+}
+`, resendTrigger)
+}
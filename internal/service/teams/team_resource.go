@@ -0,0 +1,294 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamResource{}
+	_ resource.ResourceWithConfigure   = &teamResource{}
+	_ resource.ResourceWithImportState = &teamResource{}
+)
+
+// NewTeamResource is a helper function to simplify the provider implementation.
+func NewTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+// teamResource is the resource implementation.
+type teamResource struct {
+	client *client.Client
+}
+
+// Metadata returns the resource type name.
+func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a new team in the QuickNode API. A team can only be deleted once it has no members.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "A unique identifier for the created team.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the team. Must be at least 4 characters long.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(4),
+				},
+			},
+			"default_role": schema.StringAttribute{
+				Description: "The role given to members of the team by default.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"members_count": schema.Int64Attribute{
+				Description: "The number of members in the team.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan.
+	var plan models.TeamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new team.
+	createResp, err := r.client.API.CreateTeamWithResponse(ctx, api.CreateTeamJSONRequestBody{
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating team",
+			"Could not create team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if createResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error creating team",
+			client.NewAPIError(createResp.HTTPResponse, createResp.Body),
+		))
+		return
+	}
+
+	team := createResp.JSON200.Data
+	if team.Id == nil {
+		resp.Diagnostics.AddError(
+			"Error creating team",
+			"API returned an empty response",
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(*team.Id))
+	if team.Name != nil {
+		plan.Name = types.StringValue(*team.Name)
+	}
+	plan.DefaultRole = types.StringPointerValue(team.DefaultRole)
+	plan.MembersCount = typeutil.IntPointerToInt64(team.MembersCount)
+
+	// Set state to fully populated data.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state.
+	var state models.TeamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	// Get refreshed team value from QuickNode.
+	getResp, err := r.client.API.GetTeamWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading QuickNode Team",
+			"Could not read QuickNode team ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if getResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if getResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Reading QuickNode Team",
+			client.NewAPIError(getResp.HTTPResponse, getResp.Body),
+		))
+		return
+	}
+
+	team := getResp.JSON200.Data
+	state.Name = types.StringPointerValue(team.Name)
+	state.DefaultRole = types.StringPointerValue(team.DefaultRole)
+	state.MembersCount = typeutil.IntPointerToInt64(team.MembersCount)
+
+	// Set refreshed state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Teams cannot be updated in-place. This is a bug in the provider.",
+	)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state.
+	var state models.TeamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, err := parseNumericID("team", state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team ID", err.Error())
+		return
+	}
+
+	// The API only deletes empty teams, so check for remaining members first
+	// to give a clearer error than the API does.
+	getResp, err := r.client.API.GetTeamWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Team",
+			"Could not read QuickNode team ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if getResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if getResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Team",
+			client.NewAPIError(getResp.HTTPResponse, getResp.Body),
+		))
+		return
+	}
+	if count := getResp.JSON200.Data.MembersCount; count != nil && *count > 0 {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Team",
+			fmt.Sprintf("Team %s still has %d member(s). Remove all members from the team before deleting it.", state.ID.ValueString(), *count),
+		)
+		return
+	}
+
+	// Delete existing team.
+	deleteResp, err := r.client.API.DeleteTeamWithResponse(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting QuickNode Team",
+			"Could not delete team, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if deleteResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Error Deleting QuickNode Team",
+			client.NewAPIError(deleteResp.HTTPResponse, deleteResp.Body),
+		))
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the state of the resource into the Terraform state.
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// parseNumericID converts an ID from Terraform state to the numeric ID used by the API.
+func parseNumericID(kind, id string) (int, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("expected a numeric %s ID, got: %s", kind, id)
+	}
+	return n, nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: testAccTeamResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("quicknode_team.test", "id"),
+					resource.TestCheckResourceAttr("quicknode_team.test", "name", "tf-acc-test"),
+					resource.TestCheckResourceAttr("quicknode_team.test", "members_count", "0"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "quicknode_team.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccTeamResourceConfig = `
+resource "quicknode_team" "test" {
+  name = "tf-acc-test"
+}
+`
@@ -0,0 +1,156 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package teams
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamsDataSource{}
+)
+
+// NewTeamsDataSource is a helper function to simplify the provider implementation.
+func NewTeamsDataSource() datasource.DataSource {
+	return &teamsDataSource{}
+}
+
+// teamsDataSource is the data source implementation.
+type teamsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *teamsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+// Schema defines the schema for the data source.
+func (d *teamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all teams of the account.",
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "A unique identifier for the team.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the team.",
+							Computed:    true,
+						},
+						"members_count": schema.Int64Attribute{
+							Description: "The number of members in the team.",
+							Computed:    true,
+						},
+						"users": schema.ListNestedAttribute{
+							Description: "The users that are members of the team.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: teamUserAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.TeamsDataSourceModel
+
+	tflog.Debug(ctx, "Reading QuickNode teams")
+
+	teamsResp, err := d.client.API.ListTeamsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode Teams",
+			err.Error(),
+		)
+		return
+	}
+
+	if teamsResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Unable to Read QuickNode Teams",
+			client.NewAPIError(teamsResp.HTTPResponse, teamsResp.Body),
+		))
+		return
+	}
+
+	teams := teamsResp.JSON200.Data
+
+	tflog.Debug(ctx, "Received QuickNode teams", map[string]interface{}{
+		"count": len(teams),
+	})
+
+	state.Teams = []models.TeamsTeamModel{}
+	for _, team := range teams {
+		teamState := models.TeamsTeamModel{
+			ID:           intPointerToString(team.Id),
+			Name:         types.StringPointerValue(team.Name),
+			MembersCount: typeutil.IntPointerToInt64(team.MembersCount),
+			Users:        []models.TeamUserModel{},
+		}
+
+		if team.Users != nil {
+			for _, user := range *team.Users {
+				teamState.Users = append(teamState.Users, models.TeamUserModel{
+					ID:                 intPointerToString(user.Id),
+					Email:              types.StringPointerValue(user.Email),
+					FullName:           types.StringPointerValue(user.FullName),
+					Role:               types.StringPointerValue(user.Role),
+					Status:             types.StringPointerValue(user.Status),
+					AccountPrimaryUser: types.BoolNull(),
+					CreatedAt:          types.StringPointerValue(user.CreatedAt),
+				})
+			}
+		}
+
+		state.Teams = append(state.Teams, teamState)
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,394 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/api"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/client"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/models"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/timeutil"
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/typeutil"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &rpcUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &rpcUsageDataSource{}
+)
+
+// NewRPCUsageDataSource is a helper function to simplify the provider implementation.
+func NewRPCUsageDataSource() datasource.DataSource {
+	return &rpcUsageDataSource{}
+}
+
+// rpcUsageDataSource is the data source implementation.
+type rpcUsageDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name.
+func (d *rpcUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rpc_usage"
+}
+
+// Schema defines the schema for the data source.
+func (d *rpcUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the RPC credit usage of the account for a time window, optionally grouped by endpoint, method or chain.",
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				Description: "The start of the window, either as an RFC3339 timestamp or as a duration before now such as `24h` or `7d`. " +
+					"When neither `start_time` nor `end_time` is set, the current billing period is used.",
+				Optional: true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "The end of the window, either as an RFC3339 timestamp or as a duration before now such as `1h`. Defaults to now.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("start_time")),
+				},
+			},
+			"group_by": schema.StringAttribute{
+				Description: "Break the usage down by `endpoint`, `method` or `chain`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("endpoint", "method", "chain"),
+				},
+			},
+			"window_start": schema.StringAttribute{
+				Description: "The start of the window the usage was reported for, as an RFC3339 timestamp.",
+				Computed:    true,
+			},
+			"window_end": schema.StringAttribute{
+				Description: "The end of the window the usage was reported for, as an RFC3339 timestamp.",
+				Computed:    true,
+			},
+			"credits_used": schema.Int64Attribute{
+				Description: "The number of credits used in the window.",
+				Computed:    true,
+			},
+			"credits_remaining": schema.Int64Attribute{
+				Description: "The number of credits remaining in the current billing period. Only set when no window is given.",
+				Computed:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The credit limit of the current billing period.",
+				Computed:    true,
+			},
+			"overages": schema.Int64Attribute{
+				Description: "The number of credits used above the limit.",
+				Computed:    true,
+			},
+			"requests": schema.Int64Attribute{
+				Description: "The number of requests made in the window. Only set when `group_by` is `endpoint`.",
+				Computed:    true,
+			},
+			"chain_usage": schema.ListNestedAttribute{
+				Description: "The usage per chain. Only set when `group_by` is `chain`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the chain.",
+							Computed:    true,
+						},
+						"credits_used": schema.Int64Attribute{
+							Description: "The number of credits used on the chain.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"endpoint_usage": schema.ListNestedAttribute{
+				Description: "The usage per endpoint. Only set when `group_by` is `endpoint`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the endpoint.",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "The label of the endpoint.",
+							Computed:    true,
+						},
+						"chain": schema.StringAttribute{
+							Description: "The blockchain the endpoint is associated with.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "The specific network of the blockchain.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The status of the endpoint.",
+							Computed:    true,
+						},
+						"credits_used": schema.Int64Attribute{
+							Description: "The number of credits used by the endpoint.",
+							Computed:    true,
+						},
+						"requests": schema.Int64Attribute{
+							Description: "The number of requests made to the endpoint.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"method_usage": schema.ListNestedAttribute{
+				Description: "The usage per RPC method. Only set when `group_by` is `method`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"method_name": schema.StringAttribute{
+							Description: "The name of the RPC method.",
+							Computed:    true,
+						},
+						"chain": schema.StringAttribute{
+							Description: "The blockchain the method was called on.",
+							Computed:    true,
+						},
+						"network": schema.StringAttribute{
+							Description: "The network the method was called on.",
+							Computed:    true,
+						},
+						"archive": schema.BoolAttribute{
+							Description: "Whether the calls were archive requests.",
+							Computed:    true,
+						},
+						"credits_used": schema.Int64Attribute{
+							Description: "The number of credits used by the method.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rpcUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.RPCUsageDataSourceModel
+
+	// Read the user's config (the values they set in the .tf file).
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the window. Without a start time the API uses the current billing period.
+	var startTime, endTime *int
+	if !state.StartTime.IsNull() {
+		now := time.Now()
+
+		start, err := timeutil.ParseTime(state.StartTime.ValueString(), now)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid Start Time", err.Error())
+			return
+		}
+		end := now
+		if !state.EndTime.IsNull() {
+			end, err = timeutil.ParseTime(state.EndTime.ValueString(), now)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid End Time", err.Error())
+				return
+			}
+		}
+		if !start.Before(end) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("start_time"),
+				"Invalid Time Window",
+				fmt.Sprintf("start_time (%s) must be before end_time (%s).", start.Format(time.RFC3339), end.Format(time.RFC3339)),
+			)
+			return
+		}
+
+		startUnix, endUnix := int(start.Unix()), int(end.Unix())
+		startTime, endTime = &startUnix, &endUnix
+	}
+
+	tflog.Debug(ctx, "Reading QuickNode RPC usage", map[string]interface{}{
+		"start_time": state.StartTime.ValueString(),
+		"end_time":   state.EndTime.ValueString(),
+		"group_by":   state.GroupBy.ValueString(),
+	})
+
+	usageResp, err := d.client.API.UsageWithResponse(ctx, &api.UsageParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode RPC Usage",
+			err.Error(),
+		)
+		return
+	}
+	if usageResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(client.ErrorDiagnostic(
+			"Unable to Read QuickNode RPC Usage",
+			client.NewAPIError(usageResp.HTTPResponse, usageResp.Body),
+		))
+		return
+	}
+	if usageResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read QuickNode RPC Usage",
+			"API returned an empty response",
+		)
+		return
+	}
+
+	usage := usageResp.JSON200.Data
+	state.WindowStart = types.StringValue(time.Unix(int64(usage.StartTime), 0).UTC().Format(time.RFC3339))
+	state.WindowEnd = types.StringValue(time.Unix(int64(usage.EndTime), 0).UTC().Format(time.RFC3339))
+	state.CreditsUsed = types.Int64Value(int64(usage.CreditsUsed))
+	state.CreditsRemaining = typeutil.IntPointerToInt64(usage.CreditsRemaining)
+	state.Limit = typeutil.IntPointerToInt64(usage.Limit)
+	state.Overages = typeutil.IntPointerToInt64(usage.Overages)
+	state.Requests = types.Int64Null()
+	state.ChainUsage = []models.ChainUsageModel{}
+	state.EndpointUsage = []models.EndpointUsageModel{}
+	state.MethodUsage = []models.MethodUsageModel{}
+
+	switch state.GroupBy.ValueString() {
+	case "chain":
+		chainResp, err := d.client.API.UsageByChainWithResponse(ctx, &api.UsageByChainParams{
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read QuickNode RPC Usage By Chain",
+				err.Error(),
+			)
+			return
+		}
+		if chainResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
+				"Unable to Read QuickNode RPC Usage By Chain",
+				client.NewAPIError(chainResp.HTTPResponse, chainResp.Body),
+			))
+			return
+		}
+
+		if chainResp.JSON200.Data.Chains != nil {
+			for _, chain := range *chainResp.JSON200.Data.Chains {
+				state.ChainUsage = append(state.ChainUsage, models.ChainUsageModel{
+					Name:        types.StringPointerValue(chain.Name),
+					CreditsUsed: typeutil.IntPointerToInt64(chain.CreditsUsed),
+				})
+			}
+		}
+	case "endpoint":
+		endpointResp, err := d.client.API.UsageByEndpointWithResponse(ctx, &api.UsageByEndpointParams{
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read QuickNode RPC Usage By Endpoint",
+				err.Error(),
+			)
+			return
+		}
+		if endpointResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
+				"Unable to Read QuickNode RPC Usage By Endpoint",
+				client.NewAPIError(endpointResp.HTTPResponse, endpointResp.Body),
+			))
+			return
+		}
+
+		var requests int64
+		if data := endpointResp.JSON200.Data; data != nil && data.Endpoints != nil {
+			for _, endpoint := range *data.Endpoints {
+				state.EndpointUsage = append(state.EndpointUsage, models.EndpointUsageModel{
+					Name:        types.StringPointerValue(endpoint.Name),
+					Label:       types.StringPointerValue(endpoint.Label),
+					Chain:       types.StringPointerValue(endpoint.Chain),
+					Network:     types.StringPointerValue(endpoint.Network),
+					Status:      types.StringPointerValue(endpoint.Status),
+					CreditsUsed: typeutil.IntPointerToInt64(endpoint.CreditsUsed),
+					Requests:    typeutil.IntPointerToInt64(endpoint.Requests),
+				})
+				if endpoint.Requests != nil {
+					requests += int64(*endpoint.Requests)
+				}
+			}
+		}
+		state.Requests = types.Int64Value(requests)
+	case "method":
+		methodResp, err := d.client.API.UsageByMethodWithResponse(ctx, &api.UsageByMethodParams{
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read QuickNode RPC Usage By Method",
+				err.Error(),
+			)
+			return
+		}
+		if methodResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(client.ErrorDiagnostic(
+				"Unable to Read QuickNode RPC Usage By Method",
+				client.NewAPIError(methodResp.HTTPResponse, methodResp.Body),
+			))
+			return
+		}
+
+		if data := methodResp.JSON200.Data; data != nil && data.Methods != nil {
+			for _, method := range *data.Methods {
+				state.MethodUsage = append(state.MethodUsage, models.MethodUsageModel{
+					MethodName:  types.StringPointerValue(method.MethodName),
+					Chain:       types.StringPointerValue(method.Chain),
+					Network:     types.StringPointerValue(method.Network),
+					Archive:     types.BoolPointerValue(method.Archive),
+					CreditsUsed: typeutil.IntPointerToInt64(method.CreditsUsed),
+				})
+			}
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *rpcUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package usage_test
+
+import (
+	"testing"
+
+	"github.com/asyrafnorafandi/terraform-provider-quicknode/internal/provider"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRPCUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { provider.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRPCUsageDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.quicknode_rpc_usage.billing_period", "credits_used"),
+					resource.TestCheckResourceAttrSet("data.quicknode_rpc_usage.billing_period", "window_start"),
+					resource.TestCheckResourceAttrSet("data.quicknode_rpc_usage.by_endpoint", "requests"),
+					resource.TestCheckResourceAttrSet("data.quicknode_rpc_usage.by_endpoint", "endpoint_usage.#"),
+					resource.TestCheckResourceAttrSet("data.quicknode_rpc_usage.by_method", "method_usage.#"),
+					resource.TestCheckResourceAttrSet("data.quicknode_rpc_usage.by_chain", "chain_usage.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRPCUsageDataSourceConfig = `
+data "quicknode_rpc_usage" "billing_period" {}
+
+data "quicknode_rpc_usage" "by_endpoint" {
+  start_time = "7d"
+  group_by   = "endpoint"
+}
+
+data "quicknode_rpc_usage" "by_method" {
+  start_time = "7d"
+  end_time   = "1h"
+  group_by   = "method"
+}
+
+data "quicknode_rpc_usage" "by_chain" {
+  start_time = "2024-01-01T00:00:00Z"
+  group_by   = "chain"
+}
+`
@@ -0,0 +1,39 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeutil parses the time arguments accepted by data sources.
+package timeutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTime parses either an RFC3339 timestamp or a duration relative to now,
+// such as "24h", "90m" or "7d". Durations always point to the past, so "24h"
+// and "-24h" both mean 24 hours before now.
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	d, err := parseDuration(strings.TrimPrefix(value, "-"))
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected an RFC3339 timestamp such as 2024-01-02T15:04:05Z or a duration such as 24h or 7d", value)
+	}
+	return now.Add(-d), nil
+}
+
+// parseDuration extends time.ParseDuration with a "d" unit for days.
+func parseDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
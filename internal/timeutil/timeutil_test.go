@@ -0,0 +1,55 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package timeutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime_RFC3339(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	got, err := ParseTime("2024-05-01T00:00:00Z", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestParseTime_RelativeDurations(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		"24h":  now.Add(-24 * time.Hour),
+		"-24h": now.Add(-24 * time.Hour),
+		"90m":  now.Add(-90 * time.Minute),
+		"7d":   now.Add(-7 * 24 * time.Hour),
+		"0s":   now,
+	}
+
+	for value, want := range tests {
+		got, err := ParseTime(value, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", value, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("%s: expected %s, got %s", value, want, got)
+		}
+	}
+}
+
+func TestParseTime_Invalid(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{"", "yesterday", "2024-05-01", "xd", "--1h"} {
+		if _, err := ParseTime(value, now); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
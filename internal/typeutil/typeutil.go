@@ -0,0 +1,37 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+// Package typeutil converts values between the API and Terraform.
+package typeutil
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IntPointerToInt64 converts an optional API integer to a Terraform Int64 value.
+func IntPointerToInt64(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
+
+// ExpandStringSet converts a Terraform set of strings to a Go string slice.
+func ExpandStringSet(ctx context.Context, set types.Set) []string {
+	var result []string
+	set.ElementsAs(ctx, &result, false)
+	return result
+}
+
+// FlattenStringSet converts a Go string slice to a Terraform set of strings.
+func FlattenStringSet(values []string) types.Set {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	s, _ := types.SetValue(types.StringType, elems)
+	return s
+}
@@ -0,0 +1,35 @@
+// Copyright (c) Asyraf Norafandi
+// SPDX-License-Identifier: MPL-2.0
+
+package typeutil
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestIntPointerToInt64(t *testing.T) {
+	if got := IntPointerToInt64(nil); !got.IsNull() {
+		t.Errorf("expected null, got %s", got)
+	}
+
+	v := 42
+	if got := IntPointerToInt64(&v); got.ValueInt64() != 42 {
+		t.Errorf("expected 42, got %s", got)
+	}
+}
+
+func TestStringSet(t *testing.T) {
+	set := FlattenStringSet([]string{"b", "a"})
+	if len(set.Elements()) != 2 {
+		t.Fatalf("expected 2 elements, got %s", set)
+	}
+
+	got := ExpandStringSet(context.Background(), set)
+	sort.Strings(got)
+	if want := []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}